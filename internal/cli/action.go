@@ -24,6 +24,23 @@ func (c *PortClient) ReadAction(ctx context.Context, id string) (*Action, int, e
 	return &pb.Action, resp.StatusCode(), nil
 }
 
+func (c *PortClient) ReadActions(ctx context.Context) ([]Action, error) {
+	pb := &PortBody{}
+	url := "v1/actions"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read actions, got: %s", resp.Body())
+	}
+	return pb.Actions, nil
+}
+
 func (c *PortClient) CreateAction(ctx context.Context, action *Action) (*Action, error) {
 	url := "v1/actions"
 	resp, err := c.Client.R().
@@ -85,3 +102,29 @@ func (c *PortClient) DeleteAction(ctx context.Context, actionID string) error {
 	}
 	return nil
 }
+
+func (t Trigger) MarshalJSON() ([]byte, error) {
+	type trigger Trigger
+	if t.RawUserInputs == nil {
+		return json.Marshal(trigger(t))
+	}
+	return json.Marshal(struct {
+		trigger
+		UserInputs map[string]any `json:"userInputs"`
+	}{trigger(t), t.RawUserInputs})
+}
+
+func (t *Trigger) UnmarshalJSON(data []byte) error {
+	type trigger Trigger
+	if err := json.Unmarshal(data, (*trigger)(t)); err != nil {
+		return err
+	}
+	var raw struct {
+		UserInputs map[string]any `json:"userInputs"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	t.RawUserInputs = raw.UserInputs
+	return nil
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (c *PortClient) CreateActionRun(ctx context.Context, actionIdentifier string, run *ActionRunRequest) (*ActionRun, error) {
+	url := "v1/actions/{action_identifier}/runs"
+	resp, err := c.Client.R().
+		SetBody(run).
+		SetContext(ctx).
+		SetPathParam("action_identifier", actionIdentifier).
+		Post(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to create action run, got: %s", resp.Body())
+	}
+	return &pb.Run, nil
+}
+
+func (c *PortClient) ReadActionRun(ctx context.Context, runID string) (*ActionRun, int, error) {
+	pb := &PortBody{}
+	url := "v1/actions/runs/{run_id}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		SetPathParam("run_id", runID).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read action run, got: %s", resp.Body())
+	}
+	return &pb.Run, resp.StatusCode(), nil
+}
+
+// UpdateActionRun reports the status of a run, as the backend that handles the run does
+func (c *PortClient) UpdateActionRun(ctx context.Context, runID string, update *ActionRunUpdate) (*ActionRun, error) {
+	url := "v1/actions/runs/{run_id}"
+	resp, err := c.Client.R().
+		SetBody(update).
+		SetContext(ctx).
+		SetPathParam("run_id", runID).
+		Patch(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to update action run, got: %s", resp.Body())
+	}
+	return &pb.Run, nil
+}
+
+func (c *PortClient) ReadActionRunLogs(ctx context.Context, runID string) ([]ActionRunLog, error) {
+	pb := &PortBody{}
+	url := "v1/actions/runs/{run_id}/logs"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		SetPathParam("run_id", runID).
+		Get(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to read action run logs, got: %s", resp.Body())
+	}
+	return pb.RunLogs, nil
+}
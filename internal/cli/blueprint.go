@@ -26,6 +26,7 @@ func (c *PortClient) ReadBlueprint(ctx context.Context, id string) (*Blueprint,
 }
 
 func (c *PortClient) CreateBlueprint(ctx context.Context, b *Blueprint, createCatalogPage *bool) (*Blueprint, error) {
+	defer c.InvalidateCachedBlueprint(b.Identifier)
 	url := "v1/blueprints"
 	request := c.Client.R().
 		SetBody(b).
@@ -49,6 +50,7 @@ func (c *PortClient) CreateBlueprint(ctx context.Context, b *Blueprint, createCa
 }
 
 func (c *PortClient) UpdateBlueprint(ctx context.Context, b *Blueprint, id string) (*Blueprint, error) {
+	defer c.InvalidateCachedBlueprint(id)
 	url := "v1/blueprints/{identifier}"
 	resp, err := c.Client.R().
 		SetBody(b).
@@ -70,6 +72,7 @@ func (c *PortClient) UpdateBlueprint(ctx context.Context, b *Blueprint, id strin
 }
 
 func (c *PortClient) DeleteBlueprint(ctx context.Context, id string) error {
+	defer c.InvalidateCachedBlueprint(id)
 	url := "v1/blueprints/{identifier}"
 	resp, err := c.Client.R().
 		SetContext(ctx).
@@ -91,6 +94,7 @@ func (c *PortClient) DeleteBlueprint(ctx context.Context, id string) error {
 }
 
 func (c *PortClient) DeleteBlueprintWithAllEntities(ctx context.Context, id string) (*string, error) {
+	defer c.InvalidateCachedBlueprint(id)
 	url := "v1/blueprints/{identifier}/all-entities?delete_blueprint=true"
 	resp, err := c.Client.R().
 		SetContext(ctx).
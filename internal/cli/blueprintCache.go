@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultBlueprintCacheTTL is how long a blueprint read by ReadCachedBlueprint is reused before it is read again
+const DefaultBlueprintCacheTTL = 5 * time.Minute
+
+type blueprintCacheEntry struct {
+	// done is closed once the blueprint was read, concurrent reads of the same blueprint wait for it instead of
+	// reading it again
+	done       chan struct{}
+	blueprint  *Blueprint
+	statusCode int
+	err        error
+	expiresAt  time.Time
+}
+
+type blueprintCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*blueprintCacheEntry
+}
+
+func newBlueprintCache(ttl time.Duration) *blueprintCache {
+	return &blueprintCache{
+		ttl:     ttl,
+		entries: make(map[string]*blueprintCacheEntry),
+	}
+}
+
+func (bc *blueprintCache) get(id string, read func() (*Blueprint, int, error)) (*Blueprint, int, error) {
+	bc.mu.Lock()
+	if e, ok := bc.entries[id]; ok {
+		select {
+		case <-e.done:
+			if time.Now().Before(e.expiresAt) {
+				bc.mu.Unlock()
+				return e.blueprint, e.statusCode, nil
+			}
+		default:
+			bc.mu.Unlock()
+			<-e.done
+			return e.blueprint, e.statusCode, e.err
+		}
+	}
+
+	e := &blueprintCacheEntry{done: make(chan struct{})}
+	bc.entries[id] = e
+	bc.mu.Unlock()
+
+	e.blueprint, e.statusCode, e.err = read()
+	e.expiresAt = time.Now().Add(bc.ttl)
+
+	// failed reads are not cached, the blueprint may be created later in the same apply
+	if e.err != nil {
+		bc.mu.Lock()
+		if bc.entries[id] == e {
+			delete(bc.entries, id)
+		}
+		bc.mu.Unlock()
+	}
+	close(e.done)
+
+	return e.blueprint, e.statusCode, e.err
+}
+
+func (bc *blueprintCache) invalidate(id string) {
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+	delete(bc.entries, id)
+}
+
+// ReadCachedBlueprint reads the blueprint like ReadBlueprint, reusing a blueprint read by the same client in the last
+// blueprint cache TTL. The returned blueprint is shared between the callers and must not be modified.
+func (c *PortClient) ReadCachedBlueprint(ctx context.Context, id string) (*Blueprint, int, error) {
+	return c.blueprintCache.get(id, func() (*Blueprint, int, error) {
+		return c.ReadBlueprint(ctx, id)
+	})
+}
+
+// InvalidateCachedBlueprint makes the next ReadCachedBlueprint of the blueprint read it again
+func (c *PortClient) InvalidateCachedBlueprint(id string) {
+	c.blueprintCache.invalidate(id)
+}
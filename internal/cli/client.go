@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 )
@@ -11,9 +12,10 @@ import (
 type (
 	Option     func(*PortClient)
 	PortClient struct {
-		Client   *resty.Client
-		ClientID string
-		Token    string
+		Client         *resty.Client
+		ClientID       string
+		Token          string
+		blueprintCache *blueprintCache
 	}
 )
 
@@ -35,6 +37,7 @@ func New(baseURL string, opts ...Option) (*PortClient, error) {
 				err = json.Unmarshal(r.Body(), &b)
 				return err != nil || b["ok"] != true
 			}),
+		blueprintCache: newBlueprintCache(DefaultBlueprintCacheTTL),
 	}
 	for _, opt := range opts {
 		opt(c)
@@ -80,3 +83,9 @@ func WithToken(token string) Option {
 		pc.Client.SetAuthToken(token)
 	}
 }
+
+func WithBlueprintCacheTTL(ttl time.Duration) Option {
+	return func(pc *PortClient) {
+		pc.blueprintCache = newBlueprintCache(ttl)
+	}
+}
@@ -30,13 +30,15 @@ func (c *PortClient) ReadEntity(ctx context.Context, id string, blueprint string
 	return &pb.Entity, resp.StatusCode(), nil
 }
 
-func (c *PortClient) CreateEntity(ctx context.Context, e *Entity, runID string) (*Entity, error) {
+func (c *PortClient) CreateEntity(ctx context.Context, e *Entity, runID string, merge bool) (*Entity, error) {
 	url := "v1/blueprints/{blueprint}/entities"
 	pb := &PortBody{}
 	resp, err := c.Client.R().
 		SetBody(e).
 		SetPathParam(("blueprint"), e.Blueprint).
 		SetQueryParam("upsert", "true").
+		// merge keeps the properties and relations of an existing entity that are not part of the body
+		SetQueryParam("merge", fmt.Sprintf("%t", merge)).
 		SetQueryParam("run_id", runID).
 		SetResult(&pb).
 		Post(url)
@@ -68,6 +70,40 @@ func (c *PortClient) UpdateEntity(ctx context.Context, id string, blueprint stri
 	return &pb.Entity, nil
 }
 
+// PatchEntity updates only the fields present in the body, properties and relations that are not part of it are
+// left untouched, and a nil value unsets the field. The team is sent whenever it is non-nil, so an empty team clears it.
+func (c *PortClient) PatchEntity(ctx context.Context, id string, blueprint string, e *Entity, runID string) (*Entity, error) {
+	url := "v1/blueprints/{blueprint}/entities/{identifier}"
+	pb := &PortBody{}
+	body := map[string]any{}
+	if e.Properties != nil {
+		body["properties"] = e.Properties
+	}
+	if e.Relations != nil {
+		body["relations"] = e.Relations
+	}
+	if e.Title != "" {
+		body["title"] = e.Title
+	}
+	if e.Team != nil {
+		body["team"] = e.Team
+	}
+	resp, err := c.Client.R().
+		SetBody(body).
+		SetPathParam(("blueprint"), blueprint).
+		SetPathParam("identifier", id).
+		SetQueryParam("run_id", runID).
+		SetResult(&pb).
+		Patch(url)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to patch entity, got: %s", resp.Body())
+	}
+	return &pb.Entity, nil
+}
+
 func (c *PortClient) DeleteEntity(ctx context.Context, id string, blueprint string) error {
 	url := "v1/blueprints/{blueprint}/entities/{identifier}"
 	pb := &PortBody{}
@@ -103,10 +103,13 @@ type (
 		JqQuery string `json:"jqQuery,omitempty"`
 	}
 	DatasetRule struct {
-		Blueprint *string       `json:"blueprint,omitempty"`
-		Property  *string       `json:"property,omitempty"`
-		Operator  string        `json:"operator,omitempty"`
-		Value     *DatasetValue `json:"value,omitempty"`
+		Blueprint  *string       `json:"blueprint,omitempty"`
+		Property   *string       `json:"property,omitempty"`
+		Operator   string        `json:"operator,omitempty"`
+		Value      any           `json:"value,omitempty"`
+		Direction  *string       `json:"direction,omitempty"`
+		Combinator *string       `json:"combinator,omitempty"`
+		Rules      []DatasetRule `json:"rules,omitempty"`
 	}
 	Dataset struct {
 		Combinator string        `json:"combinator,omitempty"`
@@ -177,12 +180,31 @@ type (
 		Webhook              *string           `json:"webhook,omitempty"`
 		BlueprintIdentifier  *string           `json:"blueprintIdentifier,omitempty"`
 		Mapping              *MappingSchema    `json:"mapping,omitempty"`
+		Tls                  *WebhookTls       `json:"tls,omitempty"`
+		Timeout              *int64            `json:"timeout,omitempty"`
+		InstallationId       *string           `json:"installationId,omitempty"`
+		OmitUserInputs       any               `json:"omitUserInputs,omitempty"`
+		OmitPayload          any               `json:"omitPayload,omitempty"`
+
+		IntegrationActionType                *string `json:"integrationActionType,omitempty"`
+		IntegrationActionExecutionProperties any     `json:"integrationActionExecutionProperties,omitempty"`
+	}
+
+	WebhookTls struct {
+		Verify        *bool   `json:"verify,omitempty"`
+		CaCertificate *string `json:"caCertificate,omitempty"`
 	}
 
 	ApprovalNotification struct {
-		Type   string  `json:"type,omitempty"`
-		Url    string  `json:"url,omitempty"`
-		Format *string `json:"format,omitempty"`
+		Type    string  `json:"type,omitempty"`
+		Url     string  `json:"url,omitempty"`
+		Format  *string `json:"format,omitempty"`
+		Channel *string `json:"channel,omitempty"`
+	}
+
+	ApprovalTimeout struct {
+		Minutes    int64 `json:"minutes"`
+		AutoReject bool  `json:"autoReject"`
 	}
 
 	ChangelogDestination struct {
@@ -199,12 +221,21 @@ type (
 		Properties map[string]ActionProperty `json:"properties"`
 		Required   any                       `json:"required,omitempty"`
 		Order      []string                  `json:"order,omitempty"`
+		Steps      []ActionStep              `json:"steps,omitempty"`
+	}
+
+	ActionStep struct {
+		Title string   `json:"title"`
+		Order []string `json:"order"`
 	}
 
 	TriggerEvent struct {
 		Type                string  `json:"type"`
 		BlueprintIdentifier *string `json:"blueprintIdentifier,omitempty"`
 		PropertyIdentifier  *string `json:"propertyIdentifier,omitempty"`
+		ActionIdentifier    *string `json:"actionIdentifier,omitempty"`
+		Status              *string `json:"status,omitempty"`
+		Cron                *string `json:"cron,omitempty"`
 	}
 
 	TriggerCondition struct {
@@ -221,6 +252,9 @@ type (
 		UserInputs          *ActionUserInputs `json:"userInputs,omitempty"`
 		Event               *TriggerEvent     `json:"event,omitempty"`
 		Condition           *TriggerCondition `json:"condition,omitempty"`
+		// RawUserInputs are the user inputs as generic JSON, so keys that ActionUserInputs doesn't model are kept.
+		// They are filled when the trigger is read, and replace UserInputs when the trigger is sent.
+		RawUserInputs map[string]any `json:"-"`
 	}
 
 	Blueprint struct {
@@ -246,8 +280,9 @@ type (
 		Description          *string               `json:"description,omitempty"`
 		Trigger              *Trigger              `json:"trigger"`
 		InvocationMethod     *InvocationMethod     `json:"invocationMethod,omitempty"`
-		RequiredApproval     *bool                 `json:"requiredApproval,omitempty"`
+		RequiredApproval     any                   `json:"requiredApproval,omitempty"`
 		ApprovalNotification *ApprovalNotification `json:"approvalNotification,omitempty"`
+		ApprovalTimeout      *ApprovalTimeout      `json:"approvalTimeout,omitempty"`
 		Publish              *bool                 `json:"publish,omitempty"`
 	}
 
@@ -285,6 +320,34 @@ type (
 		Description *string           `json:"description,omitempty"`
 	}
 
+	Folder struct {
+		Meta
+		Identifier string  `json:"identifier,omitempty"`
+		Title      *string `json:"title,omitempty"`
+		Parent     *string `json:"parent,omitempty"`
+		After      *string `json:"after,omitempty"`
+	}
+
+	SidebarItem struct {
+		Identifier  string  `json:"identifier"`
+		SidebarType string  `json:"sidebarType"`
+		Title       *string `json:"title,omitempty"`
+		Parent      *string `json:"parent,omitempty"`
+		After       *string `json:"after,omitempty"`
+	}
+
+	Sidebar struct {
+		Identifier string        `json:"identifier"`
+		Items      []SidebarItem `json:"items"`
+	}
+
+	// SidebarItemPosition is the position of a page or folder in the sidebar, null fields place the item at the root
+	// of the sidebar or first in its folder
+	SidebarItemPosition struct {
+		Parent *string `json:"parent"`
+		After  *string `json:"after"`
+	}
+
 	PageReadPermissions struct {
 		Users []string `json:"users"`
 		Roles []string `json:"roles"`
@@ -390,6 +453,53 @@ type (
 		Provider    string     `json:"provider,omitempty"`
 	}
 
+	Secret struct {
+		SecretName  string     `json:"secretName,omitempty"`
+		SecretValue *string    `json:"secretValue,omitempty"`
+		Description *string    `json:"description,omitempty"`
+		CreatedAt   *time.Time `json:"createdAt,omitempty"`
+		UpdatedAt   *time.Time `json:"updatedAt,omitempty"`
+	}
+
+	ActionRunRequest struct {
+		Entity     string         `json:"entity,omitempty"`
+		Properties map[string]any `json:"properties"`
+	}
+
+	ActionRunAction struct {
+		Identifier string `json:"identifier"`
+	}
+
+	ActionRunEntity struct {
+		Identifier string `json:"identifier"`
+	}
+
+	ActionRun struct {
+		Meta
+		ID          string           `json:"id"`
+		Status      string           `json:"status"`
+		StatusLabel *string          `json:"statusLabel,omitempty"`
+		Link        []string         `json:"link,omitempty"`
+		Summary     *string          `json:"summary,omitempty"`
+		EndedAt     *time.Time       `json:"endedAt,omitempty"`
+		Action      ActionRunAction  `json:"action"`
+		Entity      *ActionRunEntity `json:"entity,omitempty"`
+		Properties  map[string]any   `json:"properties,omitempty"`
+	}
+
+	ActionRunUpdate struct {
+		Status        *string  `json:"status,omitempty"`
+		StatusLabel   *string  `json:"statusLabel,omitempty"`
+		Link          []string `json:"link,omitempty"`
+		Summary       *string  `json:"summary,omitempty"`
+		ExternalRunID *string  `json:"externalRunId,omitempty"`
+	}
+
+	ActionRunLog struct {
+		Meta
+		Message string `json:"message"`
+	}
+
 	Migration struct {
 		Meta
 		Id              string `json:"id,omitempty"`
@@ -409,6 +519,7 @@ type (
 		Include                     []string        `json:"include,omitempty"`
 		Exclude                     []string        `json:"exclude,omitempty"`
 		AttachTitleToRelation       *bool           `json:"attach_title_to_relation,omitempty"`
+		Limit                       *int            `json:"limit,omitempty"`
 	}
 )
 
@@ -418,6 +529,7 @@ type PortBody struct {
 	Blueprint            Blueprint         `json:"blueprint"`
 	BlueprintPermissions Blueprint         `json:"blueprint_permissions"`
 	Action               Action            `json:"action"`
+	Actions              []Action          `json:"actions"`
 	ActionPermissions    ActionPermissions `json:"permissions"`
 	Webhook              Webhook           `json:"integration"`
 	Scorecard            Scorecard         `json:"Scorecard"`
@@ -425,6 +537,11 @@ type PortBody struct {
 	Page                 Page              `json:"page"`
 	MigrationId          string            `json:"migrationId"`
 	Migration            Migration         `json:"migration"`
+	Run                  ActionRun         `json:"run"`
+	RunLogs              []ActionRunLog    `json:"runLogs"`
+	Secret               Secret            `json:"secret"`
+	Folder               Folder            `json:"folder"`
+	Sidebar              Sidebar           `json:"sidebar"`
 }
 
 type SearchEntityResult struct {
@@ -472,10 +589,11 @@ type PortTeamBody struct {
 }
 
 type PortProviderModel struct {
-	ClientId types.String `tfsdk:"client_id"`
-	Secret   types.String `tfsdk:"secret"`
-	Token    types.String `tfsdk:"token"`
-	BaseUrl  types.String `tfsdk:"base_url"`
+	ClientId          types.String `tfsdk:"client_id"`
+	Secret            types.String `tfsdk:"secret"`
+	Token             types.String `tfsdk:"token"`
+	BaseUrl           types.String `tfsdk:"base_url"`
+	BlueprintCacheTTL types.Int64  `tfsdk:"blueprint_cache_ttl"`
 }
 
 type PortBodyDelete struct {
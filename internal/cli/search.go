@@ -4,19 +4,78 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 )
 
+// searchPageSize is the number of entities requested in each page of the search results
+const searchPageSize = 1000
+
+type searchPage struct {
+	OK                 bool
+	MatchingBlueprints []string
+	Next               string
+}
+
 func (c *PortClient) Search(ctx context.Context, searchRequest *SearchRequestQuery) (*SearchResult, error) {
+	searchResult := &SearchResult{OK: true}
+	matchingBlueprints := make(map[string]bool)
+
+	cursor := ""
+	for {
+		pageSize := searchPageSize
+		if searchRequest.Limit != nil {
+			remaining := *searchRequest.Limit - len(searchResult.Entities)
+			if remaining < pageSize {
+				pageSize = remaining
+			}
+		}
+
+		page, err := c.searchPage(ctx, searchRequest, cursor, pageSize, func(e Entity) {
+			searchResult.Entities = append(searchResult.Entities, e)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, blueprint := range page.MatchingBlueprints {
+			if !matchingBlueprints[blueprint] {
+				matchingBlueprints[blueprint] = true
+				searchResult.MatchingBlueprints = append(searchResult.MatchingBlueprints, blueprint)
+			}
+		}
+
+		if page.Next == "" || (searchRequest.Limit != nil && len(searchResult.Entities) >= *searchRequest.Limit) {
+			break
+		}
+		cursor = page.Next
+	}
+
+	if searchRequest.Limit != nil && len(searchResult.Entities) > *searchRequest.Limit {
+		searchResult.Entities = searchResult.Entities[:*searchRequest.Limit]
+	}
+
+	return searchResult, nil
+}
+
+// searchPage performs a single search request, decoding the entities of the response one at a time so large pages
+// are not buffered in memory as a whole
+func (c *PortClient) searchPage(ctx context.Context, searchRequest *SearchRequestQuery, cursor string, pageSize int, onEntity func(Entity)) (*searchPage, error) {
 	url := "v1/entities/search"
 
 	req := c.Client.R().
 		SetContext(ctx).
 		SetBody(*searchRequest.Query).
-		SetHeader("Accept", "application/json")
+		SetHeader("Accept", "application/json").
+		SetQueryParam("limit", fmt.Sprintf("%d", pageSize)).
+		SetDoNotParseResponse(true)
+
+	if cursor != "" {
+		req.SetQueryParam("from", cursor)
+	}
 
 	if searchRequest.ExcludeCalculatedProperties != nil {
-		req.SetQueryParam("exclude_calculated_properties", fmt.Sprintf("%v", &searchRequest.ExcludeCalculatedProperties))
+		req.SetQueryParam("exclude_calculated_properties", fmt.Sprintf("%t", *searchRequest.ExcludeCalculatedProperties))
 	}
 
 	if searchRequest.Include != nil && len(searchRequest.Include) > 0 {
@@ -28,21 +87,83 @@ func (c *PortClient) Search(ctx context.Context, searchRequest *SearchRequestQue
 	}
 
 	if searchRequest.AttachTitleToRelation != nil {
-		req.SetQueryParam("attach_title_to_relation", fmt.Sprintf("%v", &searchRequest.AttachTitleToRelation))
+		req.SetQueryParam("attach_title_to_relation", fmt.Sprintf("%t", *searchRequest.AttachTitleToRelation))
 	}
 
 	resp, err := req.Post(url)
-
 	if err != nil {
 		return nil, err
 	}
-	var searchResult SearchResult
-	err = json.Unmarshal(resp.Body(), &searchResult)
+	body := resp.RawBody()
+	defer body.Close()
+
+	if resp.IsError() {
+		b, _ := io.ReadAll(body)
+		return nil, fmt.Errorf("failed to search, got: %s", b)
+	}
+
+	page, err := decodeSearchPage(body, onEntity)
 	if err != nil {
 		return nil, err
 	}
-	if !searchResult.OK {
-		return nil, fmt.Errorf("failed to search, got: %s", resp.Body())
+	if !page.OK {
+		return nil, fmt.Errorf("failed to search, got a response that is not ok")
+	}
+	return page, nil
+}
+
+func decodeSearchPage(body io.Reader, onEntity func(Entity)) (*searchPage, error) {
+	decoder := json.NewDecoder(body)
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
 	}
-	return &searchResult, nil
+
+	page := &searchPage{}
+	for decoder.More() {
+		t, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t {
+		case "ok":
+			err = decoder.Decode(&page.OK)
+		case "matchingBlueprints":
+			err = decoder.Decode(&page.MatchingBlueprints)
+		case "next":
+			err = decoder.Decode(&page.Next)
+		case "entities":
+			err = decodeSearchEntities(decoder, onEntity)
+		default:
+			var skipped json.RawMessage
+			err = decoder.Decode(&skipped)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return page, nil
+}
+
+func decodeSearchEntities(decoder *json.Decoder, onEntity func(Entity)) error {
+	t, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	// entities is null
+	if t == nil {
+		return nil
+	}
+
+	for decoder.More() {
+		var e Entity
+		if err := decoder.Decode(&e); err != nil {
+			return err
+		}
+		onEntity(e)
+	}
+
+	_, err = decoder.Token()
+	return err
 }
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (c *PortClient) ReadSecret(ctx context.Context, secretName string) (*Secret, int, error) {
+	pb := &PortBody{}
+	url := "v1/organization/secrets/{secret_name}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		SetPathParam("secret_name", secretName).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to read secret, got: %s", resp.Body())
+	}
+	return &pb.Secret, resp.StatusCode(), nil
+}
+
+func (c *PortClient) CreateSecret(ctx context.Context, secret *Secret) (*Secret, error) {
+	url := "v1/organization/secrets"
+	resp, err := c.Client.R().
+		SetBody(secret).
+		SetContext(ctx).
+		Post(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to create secret, got: %s", resp.Body())
+	}
+	return &pb.Secret, nil
+}
+
+func (c *PortClient) UpdateSecret(ctx context.Context, secretName string, secret *Secret) (*Secret, error) {
+	url := "v1/organization/secrets/{secret_name}"
+	resp, err := c.Client.R().
+		SetBody(secret).
+		SetContext(ctx).
+		SetPathParam("secret_name", secretName).
+		Patch(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to update secret, got: %s", resp.Body())
+	}
+	return &pb.Secret, nil
+}
+
+func (c *PortClient) DeleteSecret(ctx context.Context, secretName string) error {
+	url := "v1/organization/secrets/{secret_name}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetPathParam("secret_name", secretName).
+		Delete(url)
+	if err != nil {
+		return err
+	}
+	var pb PortBodyDelete
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return err
+	}
+	if !(pb.Ok) {
+		return fmt.Errorf("failed to delete secret. got:\n%s", string(resp.Body()))
+	}
+	return nil
+}
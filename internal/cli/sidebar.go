@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+func (c *PortClient) GetSidebar(ctx context.Context, sidebarId string) (*Sidebar, int, error) {
+	pb := &PortBody{}
+	url := "v1/sidebars/{sidebar_identifier}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetResult(pb).
+		SetPathParam("sidebar_identifier", sidebarId).
+		Get(url)
+	if err != nil {
+		return nil, resp.StatusCode(), err
+	}
+	if !pb.OK {
+		return nil, resp.StatusCode(), fmt.Errorf("failed to get sidebar, got: %s", resp.Body())
+	}
+	return &pb.Sidebar, resp.StatusCode(), nil
+}
+
+// GetFolder reads a folder from the catalog sidebar, as folders are only returned as items of the sidebar. It
+// returns a 404 status code when the folder doesn't exist.
+func (c *PortClient) GetFolder(ctx context.Context, folderId string) (*Folder, int, error) {
+	sidebar, statusCode, err := c.GetSidebar(ctx, consts.CatalogSidebar)
+	if err != nil {
+		return nil, statusCode, err
+	}
+	for _, item := range sidebar.Items {
+		if item.SidebarType == consts.SidebarFolder && item.Identifier == folderId {
+			return &Folder{
+				Identifier: item.Identifier,
+				Title:      item.Title,
+				Parent:     item.Parent,
+				After:      item.After,
+			}, statusCode, nil
+		}
+	}
+	return nil, http.StatusNotFound, fmt.Errorf("folder %s was not found in the sidebar", folderId)
+}
+
+func (c *PortClient) CreateFolder(ctx context.Context, folder *Folder) (*Folder, error) {
+	url := "v1/sidebars/{sidebar_identifier}/folders"
+	resp, err := c.Client.R().
+		SetBody(folder).
+		SetContext(ctx).
+		SetPathParam("sidebar_identifier", consts.CatalogSidebar).
+		Post(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to create folder, got: %s", resp.Body())
+	}
+	return &pb.Folder, nil
+}
+
+func (c *PortClient) UpdateFolder(ctx context.Context, folderId string, folder *Folder) (*Folder, error) {
+	url := "v1/sidebars/{sidebar_identifier}/folders/{folder_identifier}"
+	resp, err := c.Client.R().
+		SetBody(folder).
+		SetContext(ctx).
+		SetPathParam("sidebar_identifier", consts.CatalogSidebar).
+		SetPathParam("folder_identifier", folderId).
+		Patch(url)
+	if err != nil {
+		return nil, err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return nil, err
+	}
+	if !pb.OK {
+		return nil, fmt.Errorf("failed to update folder, got: %s", resp.Body())
+	}
+	return &pb.Folder, nil
+}
+
+func (c *PortClient) DeleteFolder(ctx context.Context, folderId string) (int, error) {
+	url := "v1/sidebars/{sidebar_identifier}/folders/{folder_identifier}"
+	resp, err := c.Client.R().
+		SetContext(ctx).
+		SetHeader("Accept", "application/json").
+		SetPathParam("sidebar_identifier", consts.CatalogSidebar).
+		SetPathParam("folder_identifier", folderId).
+		Delete(url)
+	if err != nil {
+		return resp.StatusCode(), err
+	}
+	var pb PortBodyDelete
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return resp.StatusCode(), err
+	}
+	if !pb.Ok {
+		return resp.StatusCode(), fmt.Errorf("failed to delete folder, got: %s", resp.Body())
+	}
+	return resp.StatusCode(), nil
+}
+
+// MoveSidebarItem moves a page or folder of the catalog sidebar to the given position
+func (c *PortClient) MoveSidebarItem(ctx context.Context, item SidebarItem, position SidebarItemPosition) error {
+	req := c.Client.R().
+		SetBody(position).
+		SetContext(ctx)
+
+	var url string
+	switch item.SidebarType {
+	case consts.SidebarPage:
+		url = "v1/pages/{page_identifier}"
+		req.SetPathParam("page_identifier", item.Identifier)
+	case consts.SidebarFolder:
+		url = "v1/sidebars/{sidebar_identifier}/folders/{folder_identifier}"
+		req.SetPathParam("sidebar_identifier", consts.CatalogSidebar).
+			SetPathParam("folder_identifier", item.Identifier)
+	default:
+		return fmt.Errorf("can't move %s, sidebar items of type %s are not supported", item.Identifier, item.SidebarType)
+	}
+
+	resp, err := req.Patch(url)
+	if err != nil {
+		return err
+	}
+	var pb PortBody
+	err = json.Unmarshal(resp.Body(), &pb)
+	if err != nil {
+		return err
+	}
+	if !pb.OK {
+		return fmt.Errorf("failed to move %s %s, got: %s", item.SidebarType, item.Identifier, resp.Body())
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package consts
+
+const (
+	RunInProgress = "IN_PROGRESS"
+	RunSuccess    = "SUCCESS"
+	RunFailure    = "FAILURE"
+)
+
+func IsTerminalRunStatus(status string) bool {
+	return status == RunSuccess || status == RunFailure
+}
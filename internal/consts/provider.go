@@ -9,6 +9,7 @@ const (
 	Gitlab               = "GITLAB"
 	AzureDevops          = "AZURE_DEVOPS"
 	UpsertEntity         = "UPSERT_ENTITY"
+	IntegrationAction    = "INTEGRATION_ACTION"
 	SelfService          = "self-service"
 	Automation           = "automation"
 	EntityCreated        = "ENTITY_CREATED"
@@ -16,5 +17,10 @@ const (
 	EntityDeleted        = "ENTITY_DELETED"
 	TimerPropertyExpired = "TIMER_PROPERTY_EXPIRED"
 	AnyEntityChange      = "ANY_ENTITY_CHANGE"
+	RunCreated           = "RUN_CREATED"
+	RunUpdated           = "RUN_UPDATED"
+	RunStatusChanged     = "RUN_STATUS_CHANGED"
+	Cron                 = "CRON"
 	JqCondition          = "JQ"
+	SearchCondition      = "SEARCH"
 )
@@ -0,0 +1,5 @@
+package consts
+
+// SecretNamePattern is the pattern of the names of Port organization secrets, which are referenced by the invocation
+// methods of actions as {{ .secrets.NAME }}
+const SecretNamePattern = `^[A-Za-z0-9_-]+$`
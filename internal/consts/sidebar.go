@@ -0,0 +1,10 @@
+package consts
+
+const (
+	// CatalogSidebar is the identifier of the sidebar of the catalog, which holds the pages and folders
+	CatalogSidebar = "catalog"
+	// HomePageIdentifier is the identifier of the home page, which exists in every organization and can't be deleted
+	HomePageIdentifier = "$home"
+	SidebarPage        = "page"
+	SidebarFolder      = "folder"
+)
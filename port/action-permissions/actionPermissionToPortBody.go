@@ -38,5 +38,12 @@ func actionPermissionsToPortBody(state *PermissionsModel) (*cli.ActionPermission
 	actionPermissions.Approve.Policy = approvePolicyMap
 	actionPermissions.Execute.Policy = executePolicyMap
 
+	if state.Approve.StructuredPolicy != nil {
+		actionPermissions.Approve.Policy = policyToPortBody(state.Approve.StructuredPolicy)
+	}
+	if state.Execute.StructuredPolicy != nil {
+		actionPermissions.Execute.Policy = policyToPortBody(state.Execute.StructuredPolicy)
+	}
+
 	return &actionPermissions, nil
 }
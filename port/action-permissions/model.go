@@ -2,19 +2,39 @@ package action_permissions
 
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
+type PolicyRuleModel struct {
+	Property  types.String   `tfsdk:"property"`
+	Operator  types.String   `tfsdk:"operator"`
+	Value     types.String   `tfsdk:"value"`
+	Values    []types.String `tfsdk:"values"`
+	Blueprint types.String   `tfsdk:"blueprint"`
+}
+
+type PolicyQueryModel struct {
+	Combinator types.String      `tfsdk:"combinator"`
+	Rules      []PolicyRuleModel `tfsdk:"rules"`
+}
+
+type PolicyModel struct {
+	Queries    map[string]PolicyQueryModel `tfsdk:"queries"`
+	Conditions []types.String              `tfsdk:"conditions"`
+}
+
 type ExecuteModel struct {
-	Users       []types.String `tfsdk:"users"`
-	Roles       []types.String `tfsdk:"roles"`
-	Teams       []types.String `tfsdk:"teams"`
-	OwnedByTeam types.Bool     `tfsdk:"owned_by_team"`
-	Policy      types.String   `tfsdk:"policy"`
+	Users            []types.String `tfsdk:"users"`
+	Roles            []types.String `tfsdk:"roles"`
+	Teams            []types.String `tfsdk:"teams"`
+	OwnedByTeam      types.Bool     `tfsdk:"owned_by_team"`
+	Policy           types.String   `tfsdk:"policy"`
+	StructuredPolicy *PolicyModel   `tfsdk:"structured_policy"`
 }
 
 type ApproveModel struct {
-	Users  []types.String `tfsdk:"users"`
-	Roles  []types.String `tfsdk:"roles"`
-	Teams  []types.String `tfsdk:"teams"`
-	Policy types.String   `tfsdk:"policy"`
+	Users            []types.String `tfsdk:"users"`
+	Roles            []types.String `tfsdk:"roles"`
+	Teams            []types.String `tfsdk:"teams"`
+	Policy           types.String   `tfsdk:"policy"`
+	StructuredPolicy *PolicyModel   `tfsdk:"structured_policy"`
 }
 
 type PermissionsModel struct {
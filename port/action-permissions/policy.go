@@ -0,0 +1,281 @@
+package action_permissions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/itchyny/gojq"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+)
+
+var policyOperators = []string{
+	"=", "!=", ">", ">=", "<", "<=",
+	"contains", "doesNotContains", "containsAny",
+	"beginsWith", "doesNotBeginsWith", "endsWith", "doesNotEndsWith",
+	"in", "notIn", "isEmpty", "isNotEmpty", "relatedTo",
+}
+
+var listOperators = map[string]bool{"in": true, "notIn": true, "containsAny": true}
+
+var emptinessOperators = map[string]bool{"isEmpty": true, "isNotEmpty": true}
+
+// queryResultRegex matches the references of policy conditions to the results of the queries, e.g.
+// `.results.executingUser.entities`
+var queryResultRegex = regexp.MustCompile(`\.results\.([A-Za-z_][A-Za-z0-9_]*)`)
+
+func policyToPortBody(policy *PolicyModel) *map[string]any {
+	if policy == nil {
+		return nil
+	}
+
+	queries := map[string]any{}
+	for name, query := range policy.Queries {
+		rules := make([]any, 0, len(query.Rules))
+		for _, rule := range query.Rules {
+			r := map[string]any{
+				"operator": rule.Operator.ValueString(),
+			}
+			if !rule.Property.IsNull() {
+				r["property"] = rule.Property.ValueString()
+			}
+			if !rule.Blueprint.IsNull() {
+				r["blueprint"] = rule.Blueprint.ValueString()
+			}
+			if !rule.Value.IsNull() {
+				r["value"] = rule.Value.ValueString()
+			}
+			if rule.Values != nil {
+				r["value"] = flex.TerraformStringListToGoArray(rule.Values)
+			}
+			rules = append(rules, r)
+		}
+		queries[name] = map[string]any{
+			"combinator": query.Combinator.ValueString(),
+			"rules":      rules,
+		}
+	}
+
+	conditions := make([]any, 0, len(policy.Conditions))
+	for _, condition := range policy.Conditions {
+		conditions = append(conditions, condition.ValueString())
+	}
+
+	return &map[string]any{
+		"queries":    queries,
+		"conditions": conditions,
+	}
+}
+
+// writePolicyToResource reads a policy into the structured policy model. It returns false when the policy uses
+// anything the structured policy can't represent, such as nested rule groups or non string values, in which case it
+// should be kept as JSON.
+func writePolicyToResource(policy map[string]any) (*PolicyModel, bool) {
+	queries, ok := policy["queries"].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	conditions, ok := policy["conditions"].([]any)
+	if !ok || len(policy) != 2 {
+		return nil, false
+	}
+
+	model := &PolicyModel{
+		Queries:    map[string]PolicyQueryModel{},
+		Conditions: make([]types.String, 0, len(conditions)),
+	}
+
+	for _, condition := range conditions {
+		s, ok := condition.(string)
+		if !ok {
+			return nil, false
+		}
+		model.Conditions = append(model.Conditions, types.StringValue(s))
+	}
+
+	for name, q := range queries {
+		query, ok := q.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		combinator, ok := query["combinator"].(string)
+		if !ok {
+			return nil, false
+		}
+		rules, ok := query["rules"].([]any)
+		if !ok || len(query) != 2 {
+			return nil, false
+		}
+
+		queryModel := PolicyQueryModel{
+			Combinator: types.StringValue(combinator),
+			Rules:      make([]PolicyRuleModel, 0, len(rules)),
+		}
+		for _, r := range rules {
+			rule, ok := writePolicyRuleToResource(r)
+			if !ok {
+				return nil, false
+			}
+			queryModel.Rules = append(queryModel.Rules, *rule)
+		}
+		model.Queries[name] = queryModel
+	}
+
+	return model, true
+}
+
+func writePolicyRuleToResource(r any) (*PolicyRuleModel, bool) {
+	rule, ok := r.(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	ruleModel := &PolicyRuleModel{
+		Property:  types.StringNull(),
+		Operator:  types.StringNull(),
+		Value:     types.StringNull(),
+		Blueprint: types.StringNull(),
+	}
+	for key, value := range rule {
+		switch key {
+		case "property", "operator", "blueprint":
+			s, ok := value.(string)
+			if !ok {
+				return nil, false
+			}
+			switch key {
+			case "property":
+				ruleModel.Property = types.StringValue(s)
+			case "operator":
+				ruleModel.Operator = types.StringValue(s)
+			case "blueprint":
+				ruleModel.Blueprint = types.StringValue(s)
+			}
+		case "value":
+			switch v := value.(type) {
+			case string:
+				ruleModel.Value = types.StringValue(v)
+			case []any:
+				ruleModel.Values = make([]types.String, 0, len(v))
+				for _, item := range v {
+					s, ok := item.(string)
+					if !ok {
+						return nil, false
+					}
+					ruleModel.Values = append(ruleModel.Values, types.StringValue(s))
+				}
+			default:
+				return nil, false
+			}
+		default:
+			return nil, false
+		}
+	}
+
+	if ruleModel.Operator.IsNull() {
+		return nil, false
+	}
+	return ruleModel, true
+}
+
+// validateStructuredPolicy validates the conditions and query rules of a structured policy in the config, so
+// mistakes are found at plan time instead of when the action is executed
+func validateStructuredPolicy(ctx context.Context, config tfsdk.Config, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var policyObject types.Object
+	diags.Append(config.GetAttribute(ctx, p, &policyObject)...)
+	if diags.HasError() || policyObject.IsNull() || policyObject.IsUnknown() {
+		return diags
+	}
+
+	var policy PolicyModel
+	diags.Append(policyObject.As(ctx, &policy, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	queriesKnown := !policyObject.Attributes()["queries"].IsUnknown()
+
+	names := make([]string, 0, len(policy.Queries))
+	for name := range policy.Queries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for i, rule := range policy.Queries[name].Rules {
+			validatePolicyRule(rule, p.AtName("queries").AtMapKey(name).AtName("rules").AtListIndex(i), &diags)
+		}
+	}
+
+	for i, condition := range policy.Conditions {
+		if condition.IsNull() || condition.IsUnknown() {
+			continue
+		}
+		conditionPath := p.AtName("conditions").AtListIndex(i)
+		if _, err := gojq.Parse(condition.ValueString()); err != nil {
+			diags.AddAttributeError(conditionPath, "invalid policy condition", fmt.Sprintf("%q is not a valid jq expression: %s", condition.ValueString(), err))
+			continue
+		}
+		for _, match := range queryResultRegex.FindAllStringSubmatch(condition.ValueString(), -1) {
+			if _, ok := policy.Queries[match[1]]; !ok && queriesKnown {
+				diags.AddAttributeError(conditionPath, "invalid policy condition", fmt.Sprintf("the condition references the results of the query %s, which isn't defined in queries", match[1]))
+			}
+		}
+	}
+
+	return diags
+}
+
+func validatePolicyRule(rule PolicyRuleModel, p path.Path, diags *diag.Diagnostics) {
+	if rule.Operator.IsNull() || rule.Operator.IsUnknown() {
+		return
+	}
+	operator := rule.Operator.ValueString()
+	hasValue := !rule.Value.IsNull()
+	hasValues := rule.Values != nil
+
+	switch {
+	case emptinessOperators[operator]:
+		if hasValue || hasValues {
+			diags.AddAttributeError(p, "invalid policy rule", fmt.Sprintf("the %s operator doesn't take a value", operator))
+		}
+	case listOperators[operator]:
+		if hasValue && !rule.Value.IsUnknown() && !isTemplate(rule.Value.ValueString()) {
+			diags.AddAttributeError(p, "invalid policy rule", fmt.Sprintf("the %s operator compares to a list, use values instead of value", operator))
+		}
+		if !hasValue && !hasValues {
+			diags.AddAttributeError(p, "invalid policy rule", fmt.Sprintf("the %s operator requires values", operator))
+		}
+	default:
+		if hasValues {
+			diags.AddAttributeError(p, "invalid policy rule", fmt.Sprintf("the %s operator compares to a single value, use value instead of values", operator))
+		}
+		if !hasValue {
+			diags.AddAttributeError(p, "invalid policy rule", fmt.Sprintf("the %s operator requires a value", operator))
+		}
+	}
+
+	if operator == "relatedTo" {
+		if rule.Blueprint.IsNull() {
+			diags.AddAttributeError(p, "invalid policy rule", "the relatedTo operator requires a blueprint")
+		}
+	} else if rule.Property.IsNull() {
+		diags.AddAttributeError(p, "invalid policy rule", fmt.Sprintf("the %s operator requires a property", operator))
+	}
+}
+
+var templateRegex = regexp.MustCompile(`^\{\{.*\}\}$`)
+
+// isTemplate reports whether the value is a template, such as {{.trigger.user.teams}}, that Port resolves to a list
+// when the policy is evaluated
+func isTemplate(value string) bool {
+	return templateRegex.MatchString(value)
+}
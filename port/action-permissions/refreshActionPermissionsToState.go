@@ -11,6 +11,9 @@ func refreshActionPermissionsState(state *ActionPermissionsModel, a *cli.ActionP
 	state.ID = types.StringValue(actionId)
 	state.ActionIdentifier = types.StringValue(actionId)
 	state.BlueprintIdentifier = types.StringNull()
+	// A policy managed as a structured policy is kept structured, unless Port returns a policy it can't represent
+	executeStructured := state.Permissions != nil && state.Permissions.Execute != nil && state.Permissions.Execute.StructuredPolicy != nil
+	approveStructured := state.Permissions != nil && state.Permissions.Approve != nil && state.Permissions.Approve.StructuredPolicy != nil
 	state.Permissions = &PermissionsModel{}
 
 	state.Permissions.Execute = &ExecuteModel{}
@@ -33,12 +36,16 @@ func refreshActionPermissionsState(state *ActionPermissionsModel, a *cli.ActionP
 	state.Permissions.Execute.OwnedByTeam = flex.GoBoolToFramework(a.Execute.OwnedByTeam)
 
 	if a.Execute.Policy != nil {
-		policy, err := json.Marshal(a.Execute.Policy)
-		if err != nil {
-			return err
+		if structuredPolicy, ok := writePolicyToResource(*a.Execute.Policy); ok && executeStructured {
+			state.Permissions.Execute.StructuredPolicy = structuredPolicy
+		} else {
+			policy, err := json.Marshal(a.Execute.Policy)
+			if err != nil {
+				return err
+			}
+
+			state.Permissions.Execute.Policy = types.StringValue(string(policy))
 		}
-
-		state.Permissions.Execute.Policy = types.StringValue(string(policy))
 	}
 
 	state.Permissions.Approve = &ApproveModel{}
@@ -59,12 +66,16 @@ func refreshActionPermissionsState(state *ActionPermissionsModel, a *cli.ActionP
 	}
 
 	if a.Approve.Policy != nil {
-		policy, err := json.Marshal(a.Approve.Policy)
-		if err != nil {
-			return err
+		if structuredPolicy, ok := writePolicyToResource(*a.Approve.Policy); ok && approveStructured {
+			state.Permissions.Approve.StructuredPolicy = structuredPolicy
+		} else {
+			policy, err := json.Marshal(a.Approve.Policy)
+			if err != nil {
+				return err
+			}
+
+			state.Permissions.Approve.Policy = types.StringValue(string(policy))
 		}
-
-		state.Permissions.Approve.Policy = types.StringValue(string(policy))
 	}
 
 	return nil
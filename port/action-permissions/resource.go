@@ -11,6 +11,7 @@ import (
 
 var _ resource.Resource = &ActionPermissionsResource{}
 var _ resource.ResourceWithImportState = &ActionPermissionsResource{}
+var _ resource.ResourceWithValidateConfig = &ActionPermissionsResource{}
 
 func NewActionPermissionsResource() resource.Resource {
 	return &ActionPermissionsResource{}
@@ -2,6 +2,7 @@ package action_permissions_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -524,3 +525,129 @@ func TestAccPortActionWithEmptyFieldsExpectDefaultsToApply(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortActionPermissionsWithStructuredPolicy(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionPermissionsConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
+	resource "port_action_permissions" "create_microservice_permissions" {
+	  action_identifier = port_action.create_microservice.identifier
+	  permissions = {
+		"execute": {
+		  "roles": [
+			"Member",
+		  ],
+		  "users": [],
+		  "teams": [],
+		  "owned_by_team": false
+		},
+		"approve": {
+		  "roles": [],
+		  "users": [],
+		  "teams": [],
+		  "structured_policy": {
+			"queries": {
+			  "executingUser": {
+				"combinator": "and",
+				"rules": [
+				  {
+					"property": "$blueprint",
+					"operator": "=",
+					"value": "_user"
+				  },
+				  {
+					"property": "$identifier",
+					"operator": "=",
+					"value": "{{.trigger.user.email}}"
+				  }
+				]
+			  },
+			  "platformTeams": {
+				"combinator": "and",
+				"rules": [
+				  {
+					"property": "$identifier",
+					"operator": "in",
+					"values": ["platform", "sre"]
+				  }
+				]
+			  }
+			},
+			"conditions": [
+			  ".results.executingUser.entities | length > 0",
+			  ".results.platformTeams.entities | length > 0"
+			]
+		  }
+		}
+	  }
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActionPermissionsConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action_permissions.create_microservice_permissions", "action_identifier", actionIdentifier),
+					resource.TestCheckNoResourceAttr("port_action_permissions.create_microservice_permissions", "permissions.approve.policy"),
+					resource.TestCheckResourceAttr("port_action_permissions.create_microservice_permissions", "permissions.approve.structured_policy.queries.%", "2"),
+					resource.TestCheckResourceAttr("port_action_permissions.create_microservice_permissions", "permissions.approve.structured_policy.queries.executingUser.combinator", "and"),
+					resource.TestCheckResourceAttr("port_action_permissions.create_microservice_permissions", "permissions.approve.structured_policy.queries.executingUser.rules.1.value", "{{.trigger.user.email}}"),
+					resource.TestCheckResourceAttr("port_action_permissions.create_microservice_permissions", "permissions.approve.structured_policy.queries.platformTeams.rules.0.values.#", "2"),
+					resource.TestCheckResourceAttr("port_action_permissions.create_microservice_permissions", "permissions.approve.structured_policy.conditions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionPermissionsInvalidStructuredPolicy(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	testAccActionPermissionsConfig := func(condition string, operator string) string {
+		return testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + fmt.Sprintf(`
+	resource "port_action_permissions" "create_microservice_permissions" {
+	  action_identifier = port_action.create_microservice.identifier
+	  permissions = {
+		"execute": {
+		  "structured_policy": {
+			"queries": {
+			  "executingUser": {
+				"combinator": "and",
+				"rules": [
+				  {
+					"property": "$identifier",
+					"operator": "%s",
+					"value": "{{.trigger.user.email}}"
+				  }
+				]
+			  }
+			},
+			"conditions": [%q]
+		  }
+		},
+		"approve": {}
+	  }
+	}`, operator, condition)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccActionPermissionsConfig(".results.executingUser.entities | length >", "="),
+				ExpectError: regexp.MustCompile(`is not a valid jq expression`),
+			},
+			{
+				Config:      testAccActionPermissionsConfig(".results.approvingUser.entities | length > 0", "="),
+				ExpectError: regexp.MustCompile(`references the results of the query approvingUser`),
+			},
+			{
+				Config:      testAccActionPermissionsConfig(".results.executingUser.entities | length > 0", "isEmpty"),
+				ExpectError: regexp.MustCompile(`the isEmpty operator doesn't take a value`),
+			},
+		},
+	})
+}
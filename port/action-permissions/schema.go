@@ -2,8 +2,11 @@ package action_permissions
 
 import (
 	"context"
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -63,9 +66,13 @@ func ActionPermissionsSchema() map[string]schema.Attribute {
 							Default:             booldefault.StaticBool(true),
 						},
 						"policy": schema.StringAttribute{
-							MarkdownDescription: "The policy to use for execution",
+							MarkdownDescription: "The policy to use for execution, as a JSON string",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("structured_policy")),
+							},
 						},
+						"structured_policy": PolicySchema("execution"),
 					},
 				},
 				"approve": schema.SingleNestedAttribute{
@@ -94,15 +101,83 @@ func ActionPermissionsSchema() map[string]schema.Attribute {
 							ElementType:         types.StringType,
 						},
 						"policy": schema.StringAttribute{
-							MarkdownDescription: "The policy to use for approval",
+							MarkdownDescription: "The policy to use for approval, as a JSON string",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("structured_policy")),
+							},
 						},
+						"structured_policy": PolicySchema("approval"),
 					},
 				},
 			},
 		}}
 }
 
+func PolicySchema(verb string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The policy to use for %s, as an alternative to the JSON `policy`", verb),
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"queries": schema.MapNestedAttribute{
+				MarkdownDescription: "The queries of the policy, by name. The entities each query returns are available to the conditions as `.results.<name>.entities`",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"combinator": schema.StringAttribute{
+							MarkdownDescription: "The combinator of the rules of the query",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("and", "or"),
+							},
+						},
+						"rules": schema.ListNestedAttribute{
+							MarkdownDescription: "The rules of the query",
+							Required:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"property": schema.StringAttribute{
+										MarkdownDescription: "The property identifier, or meta property (e.g. `$identifier`, `$blueprint`, `$team`), the rule applies to",
+										Optional:            true,
+									},
+									"operator": schema.StringAttribute{
+										MarkdownDescription: "The operator of the rule",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(policyOperators...),
+										},
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The value to compare to. Can be a template, such as `{{.trigger.user.email}}`",
+										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("values")),
+										},
+									},
+									"values": schema.ListAttribute{
+										MarkdownDescription: "The values to compare to, for the `in`, `notIn` and `containsAny` operators",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+									"blueprint": schema.StringAttribute{
+										MarkdownDescription: "The blueprint of the related entities, for the `relatedTo` operator",
+										Optional:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"conditions": schema.ListAttribute{
+				MarkdownDescription: "The jq conditions of the policy, evaluated with the results of the queries. The policy allows the " + verb + " when any of them is true",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
 func (r *ActionPermissionsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: ActionPermissionsResourceMarkdownDescription,
@@ -110,6 +185,12 @@ func (r *ActionPermissionsResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+func (r *ActionPermissionsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	for _, permission := range []string{"execute", "approve"} {
+		resp.Diagnostics.Append(validateStructuredPolicy(ctx, req.Config, path.Root("permissions").AtName(permission).AtName("structured_policy"))...)
+	}
+}
+
 var ActionPermissionsResourceMarkdownDescription = `
 
 # Action Permissions resource
@@ -193,6 +274,52 @@ resource "port_action_permissions" "restart_microservice_permissions" {
   }
 }` + "\n```" + `
 
+## Example Usage with Structured Policy
+
+The policy can also be set as a ` + "`structured_policy`" + `, which is compiled to the same JSON. The jq conditions and the rules of the queries are validated when planning, instead of when the action is executed.
+
+` + "```hcl" + `
+resource "port_action_permissions" "restart_microservice_permissions" {
+  action_identifier = port_action.restart_microservice.identifier
+  permissions = {
+    "execute" : {
+      "roles" : [
+        "Admin"
+      ],
+      "users" : [],
+      "teams" : [],
+      "owned_by_team" : true
+    },
+    "approve" : {
+      "roles" : ["Member", "Admin"],
+      "users" : [],
+      "teams" : []
+      "structured_policy" : {
+        "queries" : {
+          "executingUser" : {
+            "combinator" : "and",
+            "rules" : [
+              {
+                "property" : "$blueprint",
+                "operator" : "=",
+                "value" : "_user"
+              },
+              {
+                "property" : "$identifier",
+                "operator" : "=",
+                "value" : "{{"{{.trigger.user.email}}"}}"
+              }
+            ]
+          }
+        },
+        "conditions" : [
+          ".results.executingUser.entities | length > 0"
+        ]
+      }
+    }
+  }
+}` + "\n```" + `
+
 ## Disclaimer
 
 - Action permissions are created by default when creating a new action, this means that you should use this resource when you want to change the default permissions of an action.
@@ -0,0 +1,21 @@
+package action_run
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type ActionRunModel struct {
+	ID                types.String   `tfsdk:"id"`
+	ActionIdentifier  types.String   `tfsdk:"action_identifier"`
+	EntityIdentifier  types.String   `tfsdk:"entity_identifier"`
+	Properties        types.String   `tfsdk:"properties"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Timeout           types.String   `tfsdk:"timeout"`
+	Status            types.String   `tfsdk:"status"`
+	StatusLabel       types.String   `tfsdk:"status_label"`
+	Summary           types.String   `tfsdk:"summary"`
+	Links             []types.String `tfsdk:"links"`
+	Logs              []types.String `tfsdk:"logs"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	EndedAt           types.String   `tfsdk:"ended_at"`
+}
@@ -0,0 +1,39 @@
+package action_run
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+)
+
+func refreshActionRunState(state *ActionRunModel, run *cli.ActionRun, logs []cli.ActionRunLog) {
+	// the action and the entity are read from the run only when it is imported
+	if state.ActionIdentifier.IsNull() {
+		state.ActionIdentifier = types.StringValue(run.Action.Identifier)
+		if run.Entity != nil {
+			state.EntityIdentifier = types.StringValue(run.Entity.Identifier)
+		}
+	}
+
+	state.ID = types.StringValue(run.ID)
+	state.Status = types.StringValue(run.Status)
+	state.StatusLabel = flex.GoStringToFramework(run.StatusLabel)
+	state.Summary = flex.GoStringToFramework(run.Summary)
+	state.CreatedAt = types.StringValue(run.CreatedAt.String())
+
+	if run.EndedAt != nil {
+		state.EndedAt = types.StringValue(run.EndedAt.String())
+	} else {
+		state.EndedAt = types.StringNull()
+	}
+
+	state.Links = make([]types.String, len(run.Link))
+	for i, link := range run.Link {
+		state.Links[i] = types.StringValue(link)
+	}
+
+	state.Logs = make([]types.String, len(logs))
+	for i, log := range logs {
+		state.Logs[i] = types.StringValue(log.Message)
+	}
+}
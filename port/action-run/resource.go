@@ -0,0 +1,192 @@
+package action_run
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+// runPollInterval is the time between reads of the run status while waiting for the run to complete
+const runPollInterval = 5 * time.Second
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ resource.Resource = &ActionRunResource{}
+var _ resource.ResourceWithImportState = &ActionRunResource{}
+
+func NewActionRunResource() resource.Resource {
+	return &ActionRunResource{}
+}
+
+type ActionRunResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *ActionRunResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action_run"
+}
+
+func (r *ActionRunResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *ActionRunResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *ActionRunModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	run, statusCode, err := r.portClient.ReadActionRun(ctx, state.ID.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read action run", err.Error())
+		return
+	}
+
+	logs, err := r.portClient.ReadActionRunLogs(ctx, run.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read action run logs", err.Error())
+		return
+	}
+
+	refreshActionRunState(state, run, logs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ActionRunResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *ActionRunModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runRequest := &cli.ActionRunRequest{
+		Entity:     state.EntityIdentifier.ValueString(),
+		Properties: map[string]any{},
+	}
+	if !state.Properties.IsNull() {
+		if err := json.Unmarshal([]byte(state.Properties.ValueString()), &runRequest.Properties); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("properties"), "invalid properties", err.Error())
+			return
+		}
+	}
+
+	run, err := r.portClient.CreateActionRun(ctx, state.ActionIdentifier.ValueString(), runRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create action run", err.Error())
+		return
+	}
+
+	r.waitAndWriteRunToState(ctx, state, run, resp.Diagnostics.AddError)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ActionRunResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *ActionRunModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// only wait_for_completion and timeout can change without running the action again
+	run, _, err := r.portClient.ReadActionRun(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read action run", err.Error())
+		return
+	}
+
+	r.waitAndWriteRunToState(ctx, state, run, resp.Diagnostics.AddError)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// waitAndWriteRunToState waits for the run to complete when wait_for_completion is set, and writes the last read
+// status of the run to the state. The state is written even when waiting fails, so the run is still tracked.
+func (r *ActionRunResource) waitAndWriteRunToState(ctx context.Context, state *ActionRunModel, run *cli.ActionRun, addError func(summary string, detail string)) {
+	var waitErr error
+	if state.WaitForCompletion.ValueBool() {
+		run, waitErr = r.waitForRun(ctx, run, state.Timeout.ValueString())
+	}
+
+	logs, err := r.portClient.ReadActionRunLogs(ctx, run.ID)
+	if err != nil {
+		addError("failed to read action run logs", err.Error())
+	}
+
+	refreshActionRunState(state, run, logs)
+
+	if waitErr != nil {
+		addError("failed waiting for action run", waitErr.Error())
+		return
+	}
+
+	if state.WaitForCompletion.ValueBool() && run.Status == consts.RunFailure {
+		label := ""
+		if run.StatusLabel != nil {
+			label = ": " + *run.StatusLabel
+		}
+		addError("action run failed", fmt.Sprintf("run %s of action %s failed%s", run.ID, run.Action.Identifier, label))
+	}
+}
+
+func (r *ActionRunResource) waitForRun(ctx context.Context, run *cli.ActionRun, timeout string) (*cli.ActionRun, error) {
+	waitFor, err := time.ParseDuration(timeout)
+	if err != nil {
+		return run, fmt.Errorf("invalid timeout: %s", err.Error())
+	}
+	deadline := time.Now().Add(waitFor)
+
+	for !consts.IsTerminalRunStatus(run.Status) {
+		if time.Now().After(deadline) {
+			return run, fmt.Errorf("run %s didn't complete within %s, its status is %s", run.ID, timeout, run.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return run, ctx.Err()
+		case <-time.After(runPollInterval):
+		}
+
+		current, _, err := r.portClient.ReadActionRun(ctx, run.ID)
+		if err != nil {
+			return run, err
+		}
+		run = current
+		tflog.Debug(ctx, "Waiting for action run to complete", map[string]interface{}{
+			"run_id": run.ID,
+			"status": run.Status,
+		})
+	}
+
+	return run, nil
+}
+
+func (r *ActionRunResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// action runs can't be deleted, the run is only removed from the state
+}
+
+func (r *ActionRunResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("wait_for_completion"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("timeout"), "10m")...)
+}
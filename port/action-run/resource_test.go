@@ -0,0 +1,156 @@
+package action_run_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+// testAccReportRunStatus reports the status of the run like the backend that handles the action would
+func testAccReportRunStatus(resourceName string, update *cli.ActionRunUpdate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+
+		baseURL := os.Getenv("PORT_BASE_URL")
+		if baseURL == "" {
+			baseURL = consts.DefaultBaseUrl
+		}
+		c, err := cli.New(baseURL)
+		if err != nil {
+			return err
+		}
+		ctx := context.Background()
+		if _, err = c.Authenticate(ctx, os.Getenv("PORT_CLIENT_ID"), os.Getenv("PORT_CLIENT_SECRET")); err != nil {
+			return err
+		}
+		_, err = c.UpdateActionRun(ctx, rs.Primary.ID, update)
+		return err
+	}
+}
+
+func testAccCreateBlueprintAndActionConfig(blueprintIdentifier string, actionIdentifier string) string {
+	return fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF test microservice"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			string_props = {
+			"text" = {
+				type = "string"
+				title = "text"
+				}
+			}
+		}
+	}
+
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "CREATE"
+			blueprint_identifier = port_blueprint.microservice.identifier
+			user_properties = {
+				string_props = {
+					name = {
+						title = "Name"
+					}
+				}
+			}
+		}
+		kafka_method = {}
+	}`, blueprintIdentifier, actionIdentifier)
+}
+
+func TestAccPortActionRunBasic(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionRunConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
+	resource "port_action_run" "create_microservice_run" {
+		action_identifier = port_action.create_microservice.identifier
+		properties = jsonencode({
+			"name" = "my-service"
+		})
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionRunConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "action_identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "wait_for_completion", "false"),
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "timeout", "10m"),
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "status", "IN_PROGRESS"),
+					resource.TestCheckResourceAttrSet("port_action_run.create_microservice_run", "id"),
+					resource.TestCheckResourceAttrSet("port_action_run.create_microservice_run", "created_at"),
+				),
+			},
+			{
+				ResourceName:            "port_action_run.create_microservice_run",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"properties"},
+			},
+		},
+	})
+}
+
+func TestAccPortActionRunStatusReported(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionRunConfigCreate = testAccCreateBlueprintAndActionConfig(blueprintIdentifier, actionIdentifier) + `
+	resource "port_action_run" "create_microservice_run" {
+		action_identifier = port_action.create_microservice.identifier
+		properties = jsonencode({
+			"name" = "my-service"
+		})
+	}`
+
+	status := consts.RunSuccess
+	statusLabel := "Deployed"
+	summary := "my-service was created"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionRunConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "status", consts.RunInProgress),
+					testAccReportRunStatus("port_action_run.create_microservice_run", &cli.ActionRunUpdate{
+						Status:      &status,
+						StatusLabel: &statusLabel,
+						Summary:     &summary,
+						Link:        []string{"https://example.com/my-service"},
+					}),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionRunConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "status", consts.RunSuccess),
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "status_label", statusLabel),
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "summary", summary),
+					resource.TestCheckResourceAttr("port_action_run.create_microservice_run", "links.0", "https://example.com/my-service"),
+					resource.TestCheckResourceAttrSet("port_action_run.create_microservice_run", "ended_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,156 @@
+package action_run
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+func ActionRunSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The ID of the action run",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"action_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the action to run",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"entity_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the entity to run the action on, for day-2 and delete actions",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"properties": schema.StringAttribute{
+			MarkdownDescription: "The user inputs of the run as a JSON object. Changing the user inputs runs the action again, formatting changes that keep the same JSON value don't",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIf(
+					propertiesChanged,
+					"The action runs again when the value of the properties changes",
+					"The action runs again when the value of the properties changes",
+				),
+			},
+		},
+		"wait_for_completion": schema.BoolAttribute{
+			MarkdownDescription: "Whether to wait for the run to reach a terminal status, failing the apply when the run fails",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		"timeout": schema.StringAttribute{
+			MarkdownDescription: "How long to wait for the run to complete when `wait_for_completion` is set, as a duration (e.g. `30m`). Defaults to `10m`",
+			Optional:            true,
+			Computed:            true,
+			Default:             stringdefault.StaticString("10m"),
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(durationRegex, "must be a duration, e.g. 30m or 1h"),
+			},
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "The status of the run, `IN_PROGRESS`, `SUCCESS` or `FAILURE`",
+			Computed:            true,
+		},
+		"status_label": schema.StringAttribute{
+			MarkdownDescription: "The status label reported by the run",
+			Computed:            true,
+		},
+		"summary": schema.StringAttribute{
+			MarkdownDescription: "The summary reported by the run",
+			Computed:            true,
+		},
+		"links": schema.ListAttribute{
+			MarkdownDescription: "The links reported by the run",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"logs": schema.ListAttribute{
+			MarkdownDescription: "The log messages of the run",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The creation date of the run",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ended_at": schema.StringAttribute{
+			MarkdownDescription: "The date the run reached a terminal status",
+			Computed:            true,
+		},
+	}
+}
+
+// propertiesChanged compares the properties as JSON values, so reformatting the properties or reordering their keys
+// doesn't run the action again
+func propertiesChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		resp.RequiresReplace = true
+		return
+	}
+
+	var stateValue, planValue any
+	if json.Unmarshal([]byte(req.StateValue.ValueString()), &stateValue) != nil ||
+		json.Unmarshal([]byte(req.PlanValue.ValueString()), &planValue) != nil {
+		resp.RequiresReplace = true
+		return
+	}
+	resp.RequiresReplace = !reflect.DeepEqual(stateValue, planValue)
+}
+
+func (r *ActionRunResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceMarkdownDescription,
+		Attributes:          ActionRunSchema(),
+	}
+}
+
+var ResourceMarkdownDescription = `
+
+# Action Run
+
+Runs a self-service action and tracks the status of the run. The action runs once when the resource is created, changing the action, entity or properties runs it again. Deleting the resource doesn't undo the run.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_action_run" "provision" {
+  action_identifier = "provision_environment"
+  properties = jsonencode({
+    "name"   = "staging"
+    "region" = "eu-west-1"
+  })
+  wait_for_completion = true
+  timeout             = "30m"
+}
+
+output "provision_links" {
+  value = port_action_run.provision.links
+}
+
+` + "```" + `
+`
@@ -2,49 +2,114 @@ package action
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
-func actionDataSetToPortBody(dataSet *DatasetModel) *cli.Dataset {
+func actionDataSetValueToPortBody(ctx context.Context, value *Value) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if !value.JqQuery.IsNull() {
+		return &cli.DatasetValue{
+			JqQuery: value.JqQuery.ValueString(),
+		}, nil
+	}
+	if !value.LiteralList.IsNull() {
+		return utils.TerraformListToGoArray(ctx, value.LiteralList, "string")
+	}
+	if !value.Literal.IsNull() {
+		return value.Literal.ValueString(), nil
+	}
+	if !value.NumberLiteral.IsNull() {
+		return value.NumberLiteral.ValueFloat64(), nil
+	}
+	if !value.BoolLiteral.IsNull() {
+		return value.BoolLiteral.ValueBool(), nil
+	}
+	if !value.LiteralJSON.IsNull() {
+		var literal any
+		if err := json.Unmarshal([]byte(value.LiteralJSON.ValueString()), &literal); err != nil {
+			return nil, fmt.Errorf("invalid literal_json: %w", err)
+		}
+		return literal, nil
+	}
+	return nil, nil
+}
+
+func actionDataSetRuleToPortBody(ctx context.Context, blueprint, property, operator, direction types.String, value *Value) (cli.DatasetRule, error) {
+	ruleValue, err := actionDataSetValueToPortBody(ctx, value)
+	if err != nil {
+		return cli.DatasetRule{}, err
+	}
+
+	return cli.DatasetRule{
+		Blueprint: blueprint.ValueStringPointer(),
+		Property:  property.ValueStringPointer(),
+		Operator:  operator.ValueString(),
+		Direction: direction.ValueStringPointer(),
+		Value:     ruleValue,
+	}, nil
+}
+
+func actionDataSetToPortBody(ctx context.Context, dataSet *DatasetModel) (*cli.Dataset, error) {
 	cliDateSet := &cli.Dataset{
 		Combinator: dataSet.Combinator.ValueString(),
 	}
 	rules := make([]cli.DatasetRule, 0, len(dataSet.Rules))
 	for _, rule := range dataSet.Rules {
-		dataSetRule := cli.DatasetRule{
-			Operator: rule.Operator.ValueString(),
-			Value: &cli.DatasetValue{
-				JqQuery: rule.Value.JqQuery.ValueString(),
-			},
-		}
-		if !rule.Blueprint.IsNull() {
-			blueprint := rule.Blueprint.ValueString()
-			dataSetRule.Blueprint = &blueprint
-		}
-		if !rule.Property.IsNull() {
-			rule := rule.Property.ValueString()
-			dataSetRule.Property = &rule
+		if !rule.Combinator.IsNull() {
+			groupRules := make([]cli.DatasetRule, 0, len(rule.Rules))
+			for _, groupRule := range rule.Rules {
+				dataSetRule, err := actionDataSetRuleToPortBody(ctx, groupRule.Blueprint, groupRule.Property, groupRule.Operator, groupRule.Direction, groupRule.Value)
+				if err != nil {
+					return nil, err
+				}
+				groupRules = append(groupRules, dataSetRule)
+			}
+			rules = append(rules, cli.DatasetRule{
+				Combinator: rule.Combinator.ValueStringPointer(),
+				Rules:      groupRules,
+			})
+			continue
 		}
 
+		dataSetRule, err := actionDataSetRuleToPortBody(ctx, rule.Blueprint, rule.Property, rule.Operator, rule.Direction, rule.Value)
+		if err != nil {
+			return nil, err
+		}
 		rules = append(rules, dataSetRule)
 	}
 	cliDateSet.Rules = rules
-	return cliDateSet
+	return cliDateSet, nil
 }
 
 func actionStateToPortBody(ctx context.Context, data *ActionModel) (*cli.Action, error) {
 	var err error
 	action := &cli.Action{
-		Identifier:       data.Identifier.ValueString(),
-		Title:            data.Title.ValueStringPointer(),
-		Icon:             data.Icon.ValueStringPointer(),
-		Description:      data.Description.ValueStringPointer(),
-		RequiredApproval: data.RequiredApproval.ValueBoolPointer(),
-		Publish:          data.Publish.ValueBoolPointer(),
+		Identifier:  data.Identifier.ValueString(),
+		Title:       data.Title.ValueStringPointer(),
+		Icon:        data.Icon.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		Publish:     data.Publish.ValueBoolPointer(),
+	}
+
+	if !data.RequiredApprovalJqQuery.IsNull() {
+		action.RequiredApproval = map[string]string{
+			"jqQuery": data.RequiredApprovalJqQuery.ValueString(),
+		}
+	} else if !data.RequiredApprovalMinApprovers.IsNull() {
+		action.RequiredApproval = map[string]int64{
+			"minApprovers": data.RequiredApprovalMinApprovers.ValueInt64(),
+		}
+	} else if !data.RequiredApproval.IsNull() {
+		action.RequiredApproval = data.RequiredApproval.ValueBool()
 	}
 
 	action.Trigger, err = triggerToBody(ctx, data)
@@ -69,6 +134,24 @@ func actionStateToPortBody(ctx context.Context, data *ActionModel) (*cli.Action,
 			Format: data.ApprovalWebhookNotification.Format.ValueStringPointer(),
 		}
 	}
+	if data.ApprovalSlackNotification != nil {
+		action.ApprovalNotification = &cli.ApprovalNotification{
+			Type:    "slack",
+			Channel: data.ApprovalSlackNotification.Channel.ValueStringPointer(),
+		}
+	}
+	if data.ApprovalTeamsNotification != nil {
+		action.ApprovalNotification = &cli.ApprovalNotification{
+			Type: "msTeams",
+			Url:  data.ApprovalTeamsNotification.Url.ValueString(),
+		}
+	}
+	if data.ApprovalTimeout != nil {
+		action.ApprovalTimeout = &cli.ApprovalTimeout{
+			Minutes:    data.ApprovalTimeout.Minutes.ValueInt64(),
+			AutoReject: data.ApprovalTimeout.AutoReject.ValueBool(),
+		}
+	}
 
 	return action, nil
 }
@@ -84,7 +167,20 @@ func triggerToBody(ctx context.Context, data *ActionModel) (*cli.Trigger, error)
 			},
 		}
 
-		if data.SelfServiceTrigger.UserProperties != nil {
+		if !data.SelfServiceTrigger.UserInputsJSON.IsNull() {
+			// the user inputs are sent as they are configured, so keys the provider doesn't model aren't dropped
+			userInputs, err := utils.TerraformJsonStringToGoObject(data.SelfServiceTrigger.UserInputsJSON.ValueStringPointer())
+			if err != nil {
+				return nil, err
+			}
+			if userInputs == nil || *userInputs == nil {
+				return nil, fmt.Errorf("user_inputs_json must be a JSON object")
+			}
+			if _, ok := (*userInputs)["properties"]; !ok {
+				(*userInputs)["properties"] = map[string]any{}
+			}
+			selfServiceTrigger.RawUserInputs = *userInputs
+		} else if data.SelfServiceTrigger.UserProperties != nil {
 			err := actionPropertiesToBody(ctx, selfServiceTrigger, data.SelfServiceTrigger)
 			if err != nil {
 				return nil, err
@@ -100,6 +196,14 @@ func triggerToBody(ctx context.Context, data *ActionModel) (*cli.Trigger, error)
 			selfServiceTrigger.UserInputs.Order = orderString
 		}
 
+		if data.SelfServiceTrigger.Steps != nil {
+			steps, err := stepsToBody(ctx, data.SelfServiceTrigger.Steps)
+			if err != nil {
+				return nil, err
+			}
+			selfServiceTrigger.UserInputs.Steps = steps
+		}
+
 		if !data.SelfServiceTrigger.Condition.IsNull() {
 			condition, err := utils.TerraformStringToGoType[cli.TriggerCondition](data.SelfServiceTrigger.Condition)
 			if err != nil {
@@ -124,6 +228,18 @@ func triggerToBody(ctx context.Context, data *ActionModel) (*cli.Trigger, error)
 			}
 		}
 
+		if data.AutomationTrigger.RulesCondition != nil {
+			rules := make([]any, len(data.AutomationTrigger.RulesCondition.Rules))
+			for i, rule := range data.AutomationTrigger.RulesCondition.Rules {
+				rules[i] = conditionRuleToBody(rule)
+			}
+			automationTrigger.Condition = &cli.TriggerCondition{
+				Type:       consts.SearchCondition,
+				Rules:      rules,
+				Combinator: data.AutomationTrigger.RulesCondition.Combinator.ValueStringPointer(),
+			}
+		}
+
 		if data.AutomationTrigger.EntityCreatedEvent != nil {
 			automationTrigger.Event = &cli.TriggerEvent{
 				Type:                consts.EntityCreated,
@@ -160,12 +276,76 @@ func triggerToBody(ctx context.Context, data *ActionModel) (*cli.Trigger, error)
 			}
 		}
 
+		if data.AutomationTrigger.RunCreatedEvent != nil {
+			automationTrigger.Event = &cli.TriggerEvent{
+				Type:             consts.RunCreated,
+				ActionIdentifier: data.AutomationTrigger.RunCreatedEvent.ActionIdentifier.ValueStringPointer(),
+			}
+		}
+
+		if data.AutomationTrigger.RunUpdatedEvent != nil {
+			automationTrigger.Event = &cli.TriggerEvent{
+				Type:             consts.RunUpdated,
+				ActionIdentifier: data.AutomationTrigger.RunUpdatedEvent.ActionIdentifier.ValueStringPointer(),
+			}
+		}
+
+		if data.AutomationTrigger.RunStatusChangedEvent != nil {
+			automationTrigger.Event = &cli.TriggerEvent{
+				Type:             consts.RunStatusChanged,
+				ActionIdentifier: data.AutomationTrigger.RunStatusChangedEvent.ActionIdentifier.ValueStringPointer(),
+				Status:           data.AutomationTrigger.RunStatusChangedEvent.Status.ValueStringPointer(),
+			}
+		}
+
+		if data.AutomationTrigger.CronEvent != nil {
+			automationTrigger.Event = &cli.TriggerEvent{
+				Type: consts.Cron,
+				Cron: data.AutomationTrigger.CronEvent.Cron.ValueStringPointer(),
+			}
+		}
+
 		return automationTrigger, nil
 	}
 
 	return nil, nil
 }
 
+func conditionRuleToBody(rule ConditionRuleModel) map[string]any {
+	body := map[string]any{
+		"property": rule.Property.ValueString(),
+		"operator": rule.Operator.ValueString(),
+	}
+
+	switch {
+	case !rule.Value.IsNull():
+		body["value"] = rule.Value.ValueString()
+	case !rule.NumberValue.IsNull():
+		body["value"] = rule.NumberValue.ValueFloat64()
+	case !rule.BoolValue.IsNull():
+		body["value"] = rule.BoolValue.ValueBool()
+	case rule.Values != nil:
+		body["value"] = flex.TerraformStringListToGoArray(rule.Values)
+	}
+
+	return body
+}
+
+func stepsToBody(ctx context.Context, steps []StepModel) ([]cli.ActionStep, error) {
+	result := make([]cli.ActionStep, len(steps))
+	for i, step := range steps {
+		order, err := utils.TerraformListToGoArray(ctx, step.Order, "string")
+		if err != nil {
+			return nil, err
+		}
+		result[i] = cli.ActionStep{
+			Title: step.Title.ValueString(),
+			Order: utils.InterfaceToStringArray(order),
+		}
+	}
+	return result, nil
+}
+
 func actionPropertiesToBody(ctx context.Context, actionTrigger *cli.Trigger, data *SelfServiceTriggerModel) error {
 	required := []string{}
 	props := map[string]cli.ActionProperty{}
@@ -248,6 +428,14 @@ func invocationMethodToBody(ctx context.Context, data *ActionModel) (*cli.Invoca
 			Method:       data.WebhookMethod.Method.ValueStringPointer(),
 			Headers:      headers,
 			Body:         body,
+			Timeout:      data.WebhookMethod.Timeout.ValueInt64Pointer(),
+		}
+
+		if data.WebhookMethod.Tls != nil {
+			webhookInvocation.Tls = &cli.WebhookTls{
+				Verify:        data.WebhookMethod.Tls.Verify.ValueBoolPointer(),
+				CaCertificate: data.WebhookMethod.Tls.CaCertificate.ValueStringPointer(),
+			}
 		}
 
 		return webhookInvocation, nil
@@ -263,6 +451,14 @@ func invocationMethodToBody(ctx context.Context, data *ActionModel) (*cli.Invoca
 			return nil, err
 		}
 		workflowInputs, _ := wi.(map[string]interface{})
+		omitUserInputs, err := utils.TerraformStringToGoType[interface{}](data.GithubMethod.OmitUserInputs)
+		if err != nil {
+			return nil, err
+		}
+		omitPayload, err := utils.TerraformStringToGoType[interface{}](data.GithubMethod.OmitPayload)
+		if err != nil {
+			return nil, err
+		}
 
 		githubInvocation := &cli.InvocationMethod{
 			Type:                 consts.Github,
@@ -271,6 +467,9 @@ func invocationMethodToBody(ctx context.Context, data *ActionModel) (*cli.Invoca
 			Workflow:             data.GithubMethod.Workflow.ValueStringPointer(),
 			WorkflowInputs:       workflowInputs,
 			ReportWorkflowStatus: reportWorkflowStatus,
+			InstallationId:       data.GithubMethod.InstallationId.ValueStringPointer(),
+			OmitUserInputs:       omitUserInputs,
+			OmitPayload:          omitPayload,
 		}
 
 		return githubInvocation, nil
@@ -282,6 +481,18 @@ func invocationMethodToBody(ctx context.Context, data *ActionModel) (*cli.Invoca
 			return nil, err
 		}
 		pipelineVariables, _ := pv.(map[string]interface{})
+		agent, err := utils.TerraformStringToGoType[interface{}](data.GitlabMethod.Agent)
+		if err != nil {
+			return nil, err
+		}
+		omitUserInputs, err := utils.TerraformStringToGoType[interface{}](data.GitlabMethod.OmitUserInputs)
+		if err != nil {
+			return nil, err
+		}
+		omitPayload, err := utils.TerraformStringToGoType[interface{}](data.GitlabMethod.OmitPayload)
+		if err != nil {
+			return nil, err
+		}
 
 		gitlabInvocation := &cli.InvocationMethod{
 			Type:              consts.Gitlab,
@@ -289,6 +500,10 @@ func invocationMethodToBody(ctx context.Context, data *ActionModel) (*cli.Invoca
 			GroupName:         data.GitlabMethod.GroupName.ValueStringPointer(),
 			DefaultRef:        data.GitlabMethod.DefaultRef.ValueStringPointer(),
 			PipelineVariables: pipelineVariables,
+			Agent:             agent,
+			InstallationId:    data.GitlabMethod.InstallationId.ValueStringPointer(),
+			OmitUserInputs:    omitUserInputs,
+			OmitPayload:       omitPayload,
 		}
 
 		return gitlabInvocation, nil
@@ -346,5 +561,21 @@ func invocationMethodToBody(ctx context.Context, data *ActionModel) (*cli.Invoca
 		return upsertEntityInvocation, nil
 	}
 
+	if data.IntegrationMethod != nil {
+		executionProperties, err := utils.TerraformStringToGoType[interface{}](data.IntegrationMethod.ExecutionProperties)
+		if err != nil {
+			return nil, err
+		}
+
+		integrationInvocation := &cli.InvocationMethod{
+			Type:                                 consts.IntegrationAction,
+			InstallationId:                       data.IntegrationMethod.InstallationId.ValueStringPointer(),
+			IntegrationActionType:                data.IntegrationMethod.IntegrationActionType.ValueStringPointer(),
+			IntegrationActionExecutionProperties: executionProperties,
+		}
+
+		return integrationInvocation, nil
+	}
+
 	return nil, nil
 }
@@ -0,0 +1,162 @@
+package action
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ datasource.DataSource = &ActionDataSource{}
+var _ datasource.DataSource = &ActionsDataSource{}
+
+func NewActionDataSource() datasource.DataSource {
+	return &ActionDataSource{}
+}
+
+type ActionDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *ActionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *ActionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action"
+}
+
+func (d *ActionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var identifier types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("identifier"), &identifier)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	a, _, err := d.portClient.ReadAction(ctx, identifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading action", err.Error())
+		return
+	}
+
+	state, err := refreshActionDataSourceState(ctx, a)
+	if err != nil {
+		resp.Diagnostics.AddError("failed writing action fields to data source", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// refreshActionDataSourceState reads the action into a new ActionModel, with the user inputs of self service actions
+// available both as the typed user_properties and as user_inputs_json
+func refreshActionDataSourceState(ctx context.Context, a *cli.Action) (*ActionModel, error) {
+	state := &ActionModel{}
+	if err := refreshActionState(ctx, state, a); err != nil {
+		return nil, err
+	}
+
+	if state.SelfServiceTrigger != nil {
+		userInputsJSON, err := userInputsToJSON(a, types.StringNull())
+		if err != nil {
+			return nil, err
+		}
+		state.SelfServiceTrigger.UserInputsJSON = userInputsJSON
+	}
+
+	return state, nil
+}
+
+func NewActionsDataSource() datasource.DataSource {
+	return &ActionsDataSource{}
+}
+
+type ActionsDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *ActionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *ActionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_actions"
+}
+
+func (d *ActionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ActionsDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	actions, err := d.portClient.ReadActions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed reading actions", err.Error())
+		return
+	}
+
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].Identifier < actions[j].Identifier
+	})
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", data.Blueprint.ValueString(), data.TriggerType.ValueString(), data.InvocationMethodType.ValueString()))
+	data.Identifiers = []types.String{}
+	data.Actions = []ActionModel{}
+	for i := range actions {
+		a := &actions[i]
+		if !actionMatchesFilters(a, &data) {
+			continue
+		}
+
+		state, err := refreshActionDataSourceState(ctx, a)
+		if err != nil {
+			resp.Diagnostics.AddError("failed writing action fields to data source", err.Error())
+			return
+		}
+		data.Identifiers = append(data.Identifiers, state.Identifier)
+		data.Actions = append(data.Actions, *state)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func actionMatchesFilters(a *cli.Action, data *ActionsDataModel) bool {
+	if a.Trigger == nil {
+		return false
+	}
+
+	if !data.TriggerType.IsNull() && a.Trigger.Type != data.TriggerType.ValueString() {
+		return false
+	}
+
+	if !data.InvocationMethodType.IsNull() && (a.InvocationMethod == nil || a.InvocationMethod.Type != data.InvocationMethodType.ValueString()) {
+		return false
+	}
+
+	if !data.Blueprint.IsNull() {
+		blueprint := a.Trigger.BlueprintIdentifier
+		if a.Trigger.Event != nil {
+			blueprint = a.Trigger.Event.BlueprintIdentifier
+		}
+		if blueprint == nil || *blueprint != data.Blueprint.ValueString() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,305 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+// computedAttributes converts the attributes of the action resource to computed data source attributes, so the data
+// sources expose the same fields as the resource and are read into ActionModel by refreshActionState. Attributes of a
+// type that can't be converted are reported in diags and left out.
+func computedAttributes(attributes map[string]resourceschema.Attribute, p path.Path, diags *diag.Diagnostics) map[string]schema.Attribute {
+	result := make(map[string]schema.Attribute, len(attributes))
+	for name, a := range attributes {
+		if attribute := computedAttribute(a, p.AtName(name), diags); attribute != nil {
+			result[name] = attribute
+		}
+	}
+	return result
+}
+
+func computedAttribute(a resourceschema.Attribute, p path.Path, diags *diag.Diagnostics) schema.Attribute {
+	description := a.GetMarkdownDescription()
+	sensitive := a.IsSensitive()
+
+	switch a := a.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true}
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true}
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true}
+	case resourceschema.Float64Attribute:
+		return schema.Float64Attribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true}
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true, ElementType: a.ElementType}
+	case resourceschema.MapAttribute:
+		return schema.MapAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true, ElementType: a.ElementType}
+	case resourceschema.ObjectAttribute:
+		return schema.ObjectAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true, AttributeTypes: a.AttributeTypes}
+	case resourceschema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true, Attributes: computedAttributes(a.Attributes, p, diags)}
+	case resourceschema.ListNestedAttribute:
+		return schema.ListNestedAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true, NestedObject: schema.NestedAttributeObject{
+			Attributes: computedAttributes(a.NestedObject.Attributes, p, diags),
+		}}
+	case resourceschema.MapNestedAttribute:
+		return schema.MapNestedAttribute{MarkdownDescription: description, Sensitive: sensitive, Computed: true, NestedObject: schema.NestedAttributeObject{
+			Attributes: computedAttributes(a.NestedObject.Attributes, p, diags),
+		}}
+	}
+	diags.AddAttributeError(p, "unsupported action attribute", fmt.Sprintf("attributes of type %T can't be converted to a data source attribute", a))
+	return nil
+}
+
+func ActionDataSourceSchema(diags *diag.Diagnostics) map[string]schema.Attribute {
+	attributes := computedAttributes(ActionSchema(), path.Empty(), diags)
+	attributes["identifier"] = schema.StringAttribute{
+		MarkdownDescription: "The identifier of the action",
+		Required:            true,
+	}
+	return attributes
+}
+
+func ActionsDataSourceSchema(diags *diag.Diagnostics) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"blueprint": schema.StringAttribute{
+			MarkdownDescription: "List only the actions of this blueprint, the blueprint of the self service trigger or of the automation trigger event",
+			Optional:            true,
+		},
+		"trigger_type": schema.StringAttribute{
+			MarkdownDescription: "List only the actions with this trigger type, `self-service` or `automation`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(consts.SelfService, consts.Automation),
+			},
+		},
+		"invocation_method_type": schema.StringAttribute{
+			MarkdownDescription: "List only the actions with this invocation method type, `KAFKA`, `WEBHOOK`, `GITHUB`, `GITLAB`, `AZURE_DEVOPS`, `UPSERT_ENTITY` or `INTEGRATION_ACTION`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(consts.Kafka, consts.Webhook, consts.Github, consts.Gitlab, consts.AzureDevops, consts.UpsertEntity, consts.IntegrationAction),
+			},
+		},
+		"identifiers": schema.ListAttribute{
+			MarkdownDescription: "The identifiers of the matching actions",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"actions": schema.ListNestedAttribute{
+			MarkdownDescription: "The matching actions",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: computedAttributes(ActionSchema(), path.Root("actions"), diags),
+			},
+		},
+	}
+}
+
+func FormPreviewDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"action_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the action to preview the form of. This can't be set at the same time as `user_inputs_json`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("action_identifier"), path.MatchRoot("user_inputs_json")),
+			},
+		},
+		"user_inputs_json": schema.StringAttribute{
+			MarkdownDescription: "The user inputs of the form to preview as a JSON object, in the format of the `user_inputs_json` of the self service trigger",
+			Optional:            true,
+		},
+		"entity": schema.StringAttribute{
+			MarkdownDescription: "The entity the form is opened for as a JSON object, available to the jq queries as `.entity`",
+			Optional:            true,
+		},
+		"user": schema.StringAttribute{
+			MarkdownDescription: "The user filling the form as a JSON object, available to the jq queries as `.user`",
+			Optional:            true,
+		},
+		"form": schema.StringAttribute{
+			MarkdownDescription: "The values entered in the form as a JSON object, merged over the defaults of the fields and available to the jq queries as `.form`",
+			Optional:            true,
+		},
+		"fields": schema.ListNestedAttribute{
+			MarkdownDescription: "The resolved fields of the form, in the order they are shown",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"identifier": schema.StringAttribute{
+						MarkdownDescription: "The identifier of the field",
+						Computed:            true,
+					},
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The title of the field",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The type of the field",
+						Computed:            true,
+					},
+					"step": schema.StringAttribute{
+						MarkdownDescription: "The title of the step the field is shown in",
+						Computed:            true,
+					},
+					"visible": schema.BoolAttribute{
+						MarkdownDescription: "Whether the field is visible",
+						Computed:            true,
+					},
+					"required": schema.BoolAttribute{
+						MarkdownDescription: "Whether the field is required",
+						Computed:            true,
+					},
+					"default": schema.StringAttribute{
+						MarkdownDescription: "The resolved default of the field as JSON",
+						Computed:            true,
+					},
+					"enum": schema.StringAttribute{
+						MarkdownDescription: "The resolved allowed values of the field, or of its items for arrays, as a JSON array",
+						Computed:            true,
+					},
+					"dataset": schema.StringAttribute{
+						MarkdownDescription: "The dataset of the field with the jq values of its rules resolved, as JSON",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"visible_fields": schema.ListAttribute{
+			MarkdownDescription: "The identifiers of the visible fields, in the order they are shown",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+	}
+}
+
+func (d *ActionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ActionDataSourceMarkdownDescription,
+		Attributes:          ActionDataSourceSchema(&resp.Diagnostics),
+	}
+}
+
+func (d *ActionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ActionsDataSourceMarkdownDescription,
+		Attributes:          ActionsDataSourceSchema(&resp.Diagnostics),
+	}
+}
+
+func (d *FormPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: FormPreviewDataSourceMarkdownDescription,
+		Attributes:          FormPreviewDataSourceSchema(),
+	}
+}
+
+var ActionDataSourceMarkdownDescription = `
+
+# Action Data Source
+
+The action data source allows you to read an action in Port that isn't managed by this Terraform configuration.
+
+## Example Usage
+
+### Allow the members of a team to execute an action owned by another team:
+
+` + "```hcl" + `
+
+data "port_action" "restart_microservice" {
+  identifier = "restart_microservice"
+}
+
+resource "port_action_permissions" "restart_microservice_permissions" {
+  action_identifier = data.port_action.restart_microservice.identifier
+  permissions = {
+    "execute" : {
+      "roles" : [],
+      "users" : [],
+      "teams" : ["platform"],
+      "owned_by_team" : false
+    },
+    "approve" : {
+      "roles" : [],
+      "users" : [],
+      "teams" : []
+    }
+  }
+}
+
+` + "```" + `
+`
+
+var ActionsDataSourceMarkdownDescription = `
+
+# Actions Data Source
+
+The actions data source allows you to list the actions in Port, optionally filtered by blueprint, trigger type or invocation method type.
+
+## Example Usage
+
+### List the self service actions of the microservice blueprint:
+
+` + "```hcl" + `
+
+data "port_actions" "microservice" {
+  blueprint    = "microservice"
+  trigger_type = "self-service"
+}
+
+output "microservice_actions" {
+  value = data.port_actions.microservice.identifiers
+}
+
+` + "```" + `
+`
+
+var FormPreviewDataSourceMarkdownDescription = `
+
+# Action Form Preview Data Source
+
+The action form preview data source resolves the form of a self service action locally, without executing it. The ` + "`visible`" + `, ` + "`default`" + `, ` + "`required`" + `, ` + "`enum`" + ` and dataset rule jq queries of the user inputs are evaluated against the given ` + "`entity`" + `, ` + "`user`" + ` and ` + "`form`" + `, so the resulting fields can be asserted on, for example in ` + "`terraform test`" + `.
+
+The form is previewed either for an existing action, set by ` + "`action_identifier`" + `, or for user inputs set inline by ` + "`user_inputs_json`" + `.
+
+## Example Usage
+
+### Preview the form of an action for a production entity:
+
+` + "```hcl" + `
+
+data "port_action_form_preview" "deploy" {
+  action_identifier = port_action.deploy.identifier
+  entity = jsonencode({
+    identifier = "payments"
+    properties = {
+      environment = "production"
+    }
+  })
+  user = jsonencode({
+    email = "dev@example.com"
+  })
+}
+
+output "deploy_visible_fields" {
+  value = data.port_action_form_preview.deploy.visible_fields
+}
+
+` + "```" + `
+`
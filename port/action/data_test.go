@@ -0,0 +1,152 @@
+package action_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func TestAccPortActionDataSources(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionDataSourcesConfig = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		kafka_method = {}
+	}
+
+	data "port_action" "create_microservice" {
+		identifier = port_action.create_microservice.identifier
+	}
+
+	data "port_actions" "microservice" {
+		blueprint = port_blueprint.microservice.identifier
+		trigger_type = "self-service"
+		invocation_method_type = "KAFKA"
+		depends_on = [port_action.create_microservice]
+	}
+
+	data "port_actions" "microservice_webhook" {
+		blueprint = port_blueprint.microservice.identifier
+		invocation_method_type = "WEBHOOK"
+		depends_on = [port_action.create_microservice]
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionDataSourcesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_action.create_microservice", "title", "TF Provider Test"),
+					resource.TestCheckResourceAttr("data.port_action.create_microservice", "icon", "Terraform"),
+					resource.TestCheckResourceAttr("data.port_action.create_microservice", "self_service_trigger.blueprint_identifier", identifier),
+					resource.TestCheckResourceAttr("data.port_action.create_microservice", "self_service_trigger.operation", "DAY-2"),
+					resource.TestCheckResourceAttrSet("data.port_action.create_microservice", "kafka_method.%"),
+					resource.TestCheckResourceAttr("data.port_actions.microservice", "identifiers.#", "1"),
+					resource.TestCheckResourceAttr("data.port_actions.microservice", "identifiers.0", actionIdentifier),
+					resource.TestCheckResourceAttr("data.port_actions.microservice", "actions.0.title", "TF Provider Test"),
+					resource.TestCheckResourceAttr("data.port_actions.microservice", "actions.0.self_service_trigger.operation", "DAY-2"),
+					resource.TestCheckResourceAttr("data.port_actions.microservice_webhook", "identifiers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionFormPreview(t *testing.T) {
+	var testAccActionFormPreviewConfig = `
+	data "port_action_form_preview" "deploy" {
+		user_inputs_json = jsonencode({
+			properties = {
+				environment = {
+					type    = "string"
+					title   = "Environment"
+					default = { jqQuery = ".entity.properties.environment" }
+					enum    = ["staging", "production"]
+				}
+				approver = {
+					type    = "string"
+					title   = "Approver"
+					visible = { jqQuery = ".form.environment == \"production\"" }
+					enum    = { jqQuery = "[.user.email]" }
+				}
+				services = {
+					type  = "array"
+					items = {
+						type = "string"
+						enum = ["api", "worker"]
+					}
+				}
+			}
+			required = { jqQuery = "if .form.environment == \"production\" then [\"environment\", \"approver\"] else [\"environment\"] end" }
+			steps = [
+				{
+					title = "Target"
+					order = ["environment", "services"]
+				},
+				{
+					title = "Approval"
+					order = ["approver"]
+				}
+			]
+		})
+		entity = jsonencode({
+			identifier = "payments"
+			properties = {
+				environment = "production"
+			}
+		})
+		user = jsonencode({
+			email = "dev@example.com"
+		})
+	}
+
+	data "port_action_form_preview" "staging" {
+		user_inputs_json = data.port_action_form_preview.deploy.user_inputs_json
+		entity           = data.port_action_form_preview.deploy.entity
+		form = jsonencode({
+			environment = "staging"
+		})
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionFormPreviewConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "id", "inline"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.#", "3"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.0.identifier", "environment"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.0.step", "Target"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.0.default", "\"production\""),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.0.required", "true"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.1.identifier", "services"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.1.enum", "[\"api\",\"worker\"]"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.1.required", "false"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.2.identifier", "approver"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.2.step", "Approval"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.2.visible", "true"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.2.required", "true"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "fields.2.enum", "[\"dev@example.com\"]"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.deploy", "visible_fields.#", "3"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.staging", "fields.2.visible", "false"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.staging", "fields.2.required", "false"),
+					resource.TestCheckResourceAttr("data.port_action_form_preview.staging", "visible_fields.#", "2"),
+				),
+			},
+		},
+	})
+}
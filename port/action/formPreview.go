@@ -0,0 +1,357 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/itchyny/gojq"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+var _ datasource.DataSource = &FormPreviewDataSource{}
+
+func NewFormPreviewDataSource() datasource.DataSource {
+	return &FormPreviewDataSource{}
+}
+
+// FormPreviewDataSource resolves the form of a self service action locally, evaluating the jq queries of the
+// visibility, defaults, required fields, enums and datasets of the user inputs against a given entity, user and form
+type FormPreviewDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *FormPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *FormPreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_action_form_preview"
+}
+
+func (d *FormPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state FormPreviewDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userInputs *cli.ActionUserInputs
+	if !state.ActionIdentifier.IsNull() {
+		a, _, err := d.portClient.ReadAction(ctx, state.ActionIdentifier.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed reading action", err.Error())
+			return
+		}
+		if a.Trigger.UserInputs == nil {
+			resp.Diagnostics.AddError("action has no form", fmt.Sprintf("action %s is not a self service action", state.ActionIdentifier.ValueString()))
+			return
+		}
+		userInputs = a.Trigger.UserInputs
+		state.ID = state.ActionIdentifier
+	} else {
+		parsed, err := utils.TerraformStringToGoType[cli.ActionUserInputs](state.UserInputsJSON)
+		if err != nil {
+			resp.Diagnostics.AddError("invalid user_inputs_json", err.Error())
+			return
+		}
+		userInputs = &parsed
+		state.ID = types.StringValue("inline")
+	}
+
+	jqContext := map[string]any{}
+	for key, value := range map[string]types.String{"entity": state.Entity, "user": state.User, "form": state.Form} {
+		parsed, err := formPreviewJSONInput(value)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("invalid %s", key), err.Error())
+			return
+		}
+		jqContext[key] = parsed
+	}
+
+	fields, err := resolveFormFields(ctx, userInputs, jqContext)
+	if err != nil {
+		resp.Diagnostics.AddError("failed resolving form", err.Error())
+		return
+	}
+
+	state.Fields = fields
+	state.VisibleFields = []types.String{}
+	for _, field := range fields {
+		if field.Visible.ValueBool() {
+			state.VisibleFields = append(state.VisibleFields, field.Identifier)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// formPreviewJSONInput parses an optional JSON object input, using an empty object when it isn't set
+func formPreviewJSONInput(value types.String) (map[string]any, error) {
+	if value.IsNull() || value.ValueString() == "" {
+		return map[string]any{}, nil
+	}
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(value.ValueString()), &parsed); err != nil {
+		return nil, err
+	}
+	if parsed == nil {
+		parsed = map[string]any{}
+	}
+	return parsed, nil
+}
+
+type formFieldPosition struct {
+	identifier string
+	step       *string
+}
+
+// formFieldOrder returns the fields in the order the form shows them: by steps when the form has steps, otherwise
+// by the order of the user inputs, followed by the fields that aren't ordered, sorted by identifier
+func formFieldOrder(userInputs *cli.ActionUserInputs) []formFieldPosition {
+	var positions []formFieldPosition
+	seen := map[string]bool{}
+	add := func(identifier string, step *string) {
+		if _, ok := userInputs.Properties[identifier]; !ok || seen[identifier] {
+			return
+		}
+		seen[identifier] = true
+		positions = append(positions, formFieldPosition{identifier: identifier, step: step})
+	}
+
+	if len(userInputs.Steps) > 0 {
+		for _, step := range userInputs.Steps {
+			title := step.Title
+			for _, identifier := range step.Order {
+				add(identifier, &title)
+			}
+		}
+	} else {
+		for _, identifier := range userInputs.Order {
+			add(identifier, nil)
+		}
+	}
+
+	var rest []string
+	for identifier := range userInputs.Properties {
+		if !seen[identifier] {
+			rest = append(rest, identifier)
+		}
+	}
+	sort.Strings(rest)
+	for _, identifier := range rest {
+		add(identifier, nil)
+	}
+
+	return positions
+}
+
+func resolveFormFields(ctx context.Context, userInputs *cli.ActionUserInputs, jqContext map[string]any) ([]FormFieldModel, error) {
+	positions := formFieldOrder(userInputs)
+
+	// Defaults are resolved first, so the other queries see them in .form unless the form overrides them
+	defaults := map[string]any{}
+	for _, position := range positions {
+		prop := userInputs.Properties[position.identifier]
+		if prop.Default == nil {
+			continue
+		}
+		value, err := resolveJqQueryValue(ctx, prop.Default, jqContext)
+		if err != nil {
+			return nil, fmt.Errorf("failed evaluating the default of %s: %w", position.identifier, err)
+		}
+		defaults[position.identifier] = value
+	}
+
+	form := map[string]any{}
+	for key, value := range defaults {
+		form[key] = value
+	}
+	for key, value := range jqContext["form"].(map[string]any) {
+		form[key] = value
+	}
+	jqContext["form"] = form
+
+	required, err := resolveRequiredFields(ctx, userInputs.Required, jqContext)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]FormFieldModel, 0, len(positions))
+	for _, position := range positions {
+		prop := userInputs.Properties[position.identifier]
+		field := FormFieldModel{
+			Identifier: types.StringValue(position.identifier),
+			Title:      types.StringPointerValue(prop.Title),
+			Type:       types.StringValue(prop.Type),
+			Step:       types.StringPointerValue(position.step),
+			Required:   types.BoolValue(required[position.identifier]),
+		}
+
+		visible := true
+		if prop.Visible != nil {
+			value, err := resolveJqQueryValue(ctx, prop.Visible, jqContext)
+			if err != nil {
+				return nil, fmt.Errorf("failed evaluating the visibility of %s: %w", position.identifier, err)
+			}
+			visible = value != nil && value != false
+		}
+		field.Visible = types.BoolValue(visible)
+
+		if field.Default, err = utils.GoObjectToTerraformString(defaults[position.identifier]); err != nil {
+			return nil, err
+		}
+
+		enum := prop.Enum
+		if prop.Type == "array" && prop.Items != nil {
+			enum = prop.Items["enum"]
+		}
+		if enum != nil {
+			if enum, err = resolveJqQueryValue(ctx, enum, jqContext); err != nil {
+				return nil, fmt.Errorf("failed evaluating the enum of %s: %w", position.identifier, err)
+			}
+		}
+		if field.Enum, err = utils.GoObjectToTerraformString(enum); err != nil {
+			return nil, err
+		}
+
+		field.Dataset = types.StringNull()
+		if prop.Dataset != nil {
+			dataset, err := resolveDataset(ctx, prop.Dataset, jqContext)
+			if err != nil {
+				return nil, fmt.Errorf("failed evaluating the dataset of %s: %w", position.identifier, err)
+			}
+			if field.Dataset, err = utils.GoObjectToTerraformString(dataset); err != nil {
+				return nil, err
+			}
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+// resolveRequiredFields returns the required fields of the form, which are either a list of identifiers or a jq
+// query returning one
+func resolveRequiredFields(ctx context.Context, required any, jqContext map[string]any) (map[string]bool, error) {
+	result := map[string]bool{}
+	if required == nil {
+		return result, nil
+	}
+
+	value, err := resolveJqQueryValue(ctx, required, jqContext)
+	if err != nil {
+		return nil, fmt.Errorf("failed evaluating the required fields: %w", err)
+	}
+
+	identifiers, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("the required fields must be an array of identifiers, got %v", value)
+	}
+	for _, identifier := range identifiers {
+		if s, ok := identifier.(string); ok {
+			result[s] = true
+		}
+	}
+	return result, nil
+}
+
+func resolveDataset(ctx context.Context, dataset *cli.Dataset, jqContext map[string]any) (*cli.Dataset, error) {
+	rules, err := resolveDatasetRules(ctx, dataset.Rules, jqContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cli.Dataset{
+		Combinator: dataset.Combinator,
+		Rules:      rules,
+	}, nil
+}
+
+// resolveDatasetRules copies the rules, and the rules of their nested groups, with their jq query values evaluated
+func resolveDatasetRules(ctx context.Context, rules []cli.DatasetRule, jqContext map[string]any) ([]cli.DatasetRule, error) {
+	resolved := make([]cli.DatasetRule, 0, len(rules))
+	for _, rule := range rules {
+		value, err := resolveJqQueryValue(ctx, rule.Value, jqContext)
+		if err != nil {
+			return nil, err
+		}
+		rule.Value = value
+
+		if rule.Rules != nil {
+			if rule.Rules, err = resolveDatasetRules(ctx, rule.Rules, jqContext); err != nil {
+				return nil, err
+			}
+		}
+		resolved = append(resolved, rule)
+	}
+	return resolved, nil
+}
+
+// resolveJqQueryValue evaluates values in the {"jqQuery": "..."} format, and returns any other value as is
+func resolveJqQueryValue(ctx context.Context, value any, jqContext map[string]any) (any, error) {
+	if m, ok := value.(map[string]any); ok && len(m) == 1 {
+		if query, ok := m["jqQuery"].(string); ok {
+			return evaluateJqQuery(ctx, query, jqContext)
+		}
+	}
+	return value, nil
+}
+
+// jqQueryTimeout bounds the evaluation of a single jq query, so a query that never ends fails the read instead of
+// hanging the plan
+const jqQueryTimeout = 10 * time.Second
+
+// evaluateJqQuery runs the query against the context and returns its first result, or null when it has none
+func evaluateJqQuery(ctx context.Context, query string, jqContext map[string]any) (any, error) {
+	parsed, err := gojq.Parse(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jq query %q: %w", query, err)
+	}
+
+	input, err := normalizeJqInput(jqContext)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, jqQueryTimeout)
+	defer cancel()
+
+	iter := parsed.RunWithContext(ctx, input)
+	value, ok := iter.Next()
+	if !ok {
+		return nil, nil
+	}
+	if err, ok := value.(error); ok {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("jq query %q didn't finish within %s", query, jqQueryTimeout)
+		}
+		return nil, fmt.Errorf("jq query %q failed: %w", query, err)
+	}
+	return value, nil
+}
+
+// normalizeJqInput round trips the context through JSON, as gojq only accepts the types encoding/json decodes into
+func normalizeJqInput(jqContext map[string]any) (any, error) {
+	raw, err := json.Marshal(jqContext)
+	if err != nil {
+		return nil, err
+	}
+	var input any
+	if err := json.Unmarshal(raw, &input); err != nil {
+		return nil, err
+	}
+	return input, nil
+}
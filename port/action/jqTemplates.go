@@ -0,0 +1,250 @@
+package action
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/itchyny/gojq"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+// invocationMethods are the attributes whose strings may embed {{ ... }} jq templates
+var invocationMethods = map[string]bool{
+	"kafka_method":         true,
+	"webhook_method":       true,
+	"github_method":        true,
+	"gitlab_method":        true,
+	"azure_method":         true,
+	"upsert_entity_method": true,
+	"integration_method":   true,
+}
+
+// jsonPayloads are the attributes of the invocation methods that must hold a JSON value
+var jsonPayloads = map[string]bool{
+	"payload":              true,
+	"body":                 true,
+	"workflow_inputs":      true,
+	"pipeline_variables":   true,
+	"properties":           true,
+	"relations":            true,
+	"execution_properties": true,
+}
+
+var inputReferenceRegex = regexp.MustCompile(`\.inputs(?:\.([A-Za-z_][A-Za-z0-9_]*)|\[\s*"([^"]+)"\s*\]|\."([^"]+)")`)
+
+// secretReferenceRegex matches the references to the organization secrets, the name is empty when the reference
+// doesn't name a secret
+var secretReferenceRegex = regexp.MustCompile(`(?:^|[^A-Za-z0-9_\]\)"])\.secrets\b(?:\.([A-Za-z_][A-Za-z0-9_]*)|\[\s*"([^"]*)"\s*\]|\."([^"]*)")?`)
+
+var secretNameRegex = regexp.MustCompile(consts.SecretNamePattern)
+
+// credentialHeaders are the substrings of the names of the headers that usually hold credentials
+var credentialHeaders = []string{"authorization", "token", "secret", "api-key", "apikey", "password"}
+
+// validateJqTemplates parses the jq queries of the action and the jq templates embedded in the strings of its
+// invocation method, checks that the payloads of the invocation method are valid JSON and that the secret references
+// name valid secrets, and warns about invalid jq queries, templates that reference user inputs that are not declared
+// in the self service trigger and credentials written in plain text in the headers
+func validateJqTemplates(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	declared, checkInputs := declaredUserProperties(ctx, req.Config)
+
+	var selfService types.Object
+	if diags := req.Config.GetAttribute(ctx, path.Root("self_service_trigger"), &selfService); diags.HasError() || selfService.IsNull() {
+		checkInputs = false
+	}
+
+	_ = tftypes.Walk(req.Config.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (bool, error) {
+		if !v.IsKnown() || v.IsNull() || !v.Type().Is(tftypes.String) {
+			return true, nil
+		}
+		var s string
+		if err := v.As(&s); err != nil {
+			return true, nil
+		}
+
+		steps := p.Steps()
+		attributePath := frameworkPath(steps)
+		name := lastAttributeName(steps)
+
+		switch {
+		case strings.HasSuffix(name, "jq_query") || name == "expressions":
+			// the jq queries of the form are only evaluated by Port when the form is shown, so they are reported as
+			// warnings to not fail configurations that Port accepts
+			if _, err := gojq.Parse(s); err != nil {
+				resp.Diagnostics.AddAttributeWarning(attributePath, "invalid jq query", fmt.Sprintf("%q is not a valid jq query: %s", s, err.Error()))
+			}
+		case len(steps) > 0 && invocationMethods[string(rootAttributeName(steps))]:
+			var templates []string
+			if jsonPayloads[name] && len(steps) <= 3 {
+				var payload interface{}
+				if err := json.Unmarshal([]byte(s), &payload); err != nil {
+					resp.Diagnostics.AddAttributeError(attributePath, "invalid JSON", fmt.Sprintf("%s must be valid JSON: %s", name, err.Error()))
+					return true, nil
+				}
+				templates = jsonTemplates(payload)
+			} else {
+				templates = stringTemplates(s)
+			}
+
+			if name == "headers" && len(templates) == 0 {
+				if header, ok := steps[len(steps)-1].(tftypes.ElementKeyString); ok && isCredentialHeader(string(header)) {
+					resp.Diagnostics.AddAttributeWarning(attributePath, "plain text credential",
+						fmt.Sprintf("the %s header is stored in plain text in the action, store it in a Port secret and reference it as {{ .secrets.<name> }} instead", string(header)))
+				}
+			}
+
+			for _, template := range templates {
+				validateJqTemplate(template, attributePath, declared, checkInputs, &resp.Diagnostics)
+			}
+		}
+		return true, nil
+	})
+}
+
+func validateJqTemplate(template string, p path.Path, declared map[string]bool, checkInputs bool, diags *diag.Diagnostics) {
+	expression, err := parseJqTemplate(template)
+	if err != nil {
+		diags.AddAttributeError(p, "invalid jq template", fmt.Sprintf("%q contains an invalid jq template: %s", template, err.Error()))
+		return
+	}
+
+	for _, match := range secretReferenceRegex.FindAllStringSubmatch(expression, -1) {
+		name := match[1] + match[2] + match[3]
+		if name == "" {
+			diags.AddAttributeError(p, "invalid secret reference", fmt.Sprintf("the jq template %q references .secrets without naming a secret, use {{ .secrets.<name> }}", template))
+		} else if !secretNameRegex.MatchString(name) {
+			diags.AddAttributeError(p, "invalid secret reference", fmt.Sprintf("the jq template %q references the secret %q, secret names contain only letters, digits, _ and -", template, name))
+		}
+	}
+
+	if !checkInputs {
+		return
+	}
+	for _, match := range inputReferenceRegex.FindAllStringSubmatch(expression, -1) {
+		input := match[1] + match[2] + match[3]
+		if !declared[input] {
+			diags.AddAttributeWarning(p, "undeclared user input", fmt.Sprintf("the jq template %q references .inputs.%s, which is not a user property of the action", template, input))
+		}
+	}
+}
+
+// parseJqTemplate parses the jq expressions of the {{ ... }} templates in the string. As jq expressions may contain
+// "}}" themselves (e.g. nested objects), every "}}" after the opening "{{" is tried until the expression parses.
+func parseJqTemplate(s string) (string, error) {
+	var expressions []string
+	for {
+		start := strings.Index(s, "{{")
+		if start == -1 {
+			return strings.Join(expressions, "\n"), nil
+		}
+		rest := s[start+2:]
+
+		var firstErr error
+		end := -1
+		for offset := 0; ; {
+			i := strings.Index(rest[offset:], "}}")
+			if i == -1 {
+				break
+			}
+			candidate := rest[:offset+i]
+			if _, err := gojq.Parse(candidate); err == nil {
+				end = offset + i
+				break
+			} else if firstErr == nil {
+				firstErr = err
+			}
+			offset += i + 1
+		}
+
+		if end == -1 {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("missing closing }}")
+			}
+			return "", firstErr
+		}
+		expressions = append(expressions, rest[:end])
+		s = rest[end+2:]
+	}
+}
+
+func isCredentialHeader(header string) bool {
+	header = strings.ToLower(header)
+	for _, credential := range credentialHeaders {
+		if strings.Contains(header, credential) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringTemplates(s string) []string {
+	if strings.Contains(s, "{{") {
+		return []string{s}
+	}
+	return nil
+}
+
+// jsonTemplates returns the keys and string values of the JSON value that contain templates
+func jsonTemplates(v interface{}) []string {
+	var templates []string
+	switch v := v.(type) {
+	case string:
+		templates = append(templates, stringTemplates(v)...)
+	case []interface{}:
+		for _, item := range v {
+			templates = append(templates, jsonTemplates(item)...)
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			templates = append(templates, stringTemplates(key)...)
+			templates = append(templates, jsonTemplates(v[key])...)
+		}
+	}
+	return templates
+}
+
+func rootAttributeName(steps []tftypes.AttributePathStep) tftypes.AttributeName {
+	name, _ := steps[0].(tftypes.AttributeName)
+	return name
+}
+
+func lastAttributeName(steps []tftypes.AttributePathStep) string {
+	for i := len(steps) - 1; i >= 0; i-- {
+		if name, ok := steps[i].(tftypes.AttributeName); ok {
+			return string(name)
+		}
+	}
+	return ""
+}
+
+// frameworkPath converts a tftypes attribute path to a framework path, stopping at set elements
+func frameworkPath(steps []tftypes.AttributePathStep) path.Path {
+	p := path.Empty()
+	for _, step := range steps {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			p = p.AtName(string(step))
+		case tftypes.ElementKeyString:
+			p = p.AtMapKey(string(step))
+		case tftypes.ElementKeyInt:
+			p = p.AtListIndex(int(step))
+		default:
+			return p
+		}
+	}
+	return p
+}
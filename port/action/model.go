@@ -6,14 +6,29 @@ import (
 )
 
 type Value struct {
-	JqQuery types.String `tfsdk:"jq_query"`
-}
-type Rule struct {
+	JqQuery       types.String  `tfsdk:"jq_query"`
+	Literal       types.String  `tfsdk:"literal"`
+	NumberLiteral types.Float64 `tfsdk:"number_literal"`
+	BoolLiteral   types.Bool    `tfsdk:"bool_literal"`
+	LiteralList   types.List    `tfsdk:"literal_list"`
+	LiteralJSON   types.String  `tfsdk:"literal_json"`
+}
+type GroupRule struct {
 	Blueprint types.String `tfsdk:"blueprint"`
 	Property  types.String `tfsdk:"property"`
 	Operator  types.String `tfsdk:"operator"`
+	Direction types.String `tfsdk:"direction"`
 	Value     *Value       `tfsdk:"value"`
 }
+type Rule struct {
+	Blueprint  types.String `tfsdk:"blueprint"`
+	Property   types.String `tfsdk:"property"`
+	Operator   types.String `tfsdk:"operator"`
+	Direction  types.String `tfsdk:"direction"`
+	Value      *Value       `tfsdk:"value"`
+	Combinator types.String `tfsdk:"combinator"`
+	Rules      []GroupRule  `tfsdk:"rules"`
+}
 type DatasetModel struct {
 	Combinator types.String `tfsdk:"combinator"`
 	Rules      []Rule       `tfsdk:"rules"`
@@ -275,9 +290,16 @@ type SelfServiceTriggerModel struct {
 	UserProperties      *UserPropertiesModel `tfsdk:"user_properties"`
 	RequiredJqQuery     types.String         `tfsdk:"required_jq_query"`
 	OrderProperties     types.List           `tfsdk:"order_properties"`
+	UserInputsJSON      types.String         `tfsdk:"user_inputs_json"`
+	Steps               []StepModel          `tfsdk:"steps"`
 	Condition           types.String         `tfsdk:"condition"`
 }
 
+type StepModel struct {
+	Title types.String `tfsdk:"title"`
+	Order types.List   `tfsdk:"order"`
+}
+
 type EntityCreatedEventModel struct {
 	BlueprintIdentifier types.String `tfsdk:"blueprint_identifier"`
 }
@@ -299,31 +321,74 @@ type TimerPropertyExpiredEventModel struct {
 	PropertyIdentifier  types.String `tfsdk:"property_identifier"`
 }
 
+type RunCreatedEventModel struct {
+	ActionIdentifier types.String `tfsdk:"action_identifier"`
+}
+
+type RunUpdatedEventModel struct {
+	ActionIdentifier types.String `tfsdk:"action_identifier"`
+}
+
+type RunStatusChangedEventModel struct {
+	ActionIdentifier types.String `tfsdk:"action_identifier"`
+	Status           types.String `tfsdk:"status"`
+}
+
+type CronEventModel struct {
+	Cron types.String `tfsdk:"cron"`
+}
+
 type JqConditionModel struct {
 	Expressions []types.String `tfsdk:"expressions"`
 	Combinator  types.String   `tfsdk:"combinator"`
 }
 
+type ConditionRuleModel struct {
+	Property    types.String   `tfsdk:"property"`
+	Operator    types.String   `tfsdk:"operator"`
+	Value       types.String   `tfsdk:"value"`
+	NumberValue types.Float64  `tfsdk:"number_value"`
+	BoolValue   types.Bool     `tfsdk:"bool_value"`
+	Values      []types.String `tfsdk:"values"`
+}
+
+type RulesConditionModel struct {
+	Combinator types.String         `tfsdk:"combinator"`
+	Rules      []ConditionRuleModel `tfsdk:"rules"`
+}
+
 type AutomationTriggerModel struct {
 	EntityCreatedEvent        *EntityCreatedEventModel        `tfsdk:"entity_created_event"`
 	EntityUpdatedEvent        *EntityUpdatedEventModel        `tfsdk:"entity_updated_event"`
 	EntityDeletedEvent        *EntityDeletedEventModel        `tfsdk:"entity_deleted_event"`
 	AnyEntityChangeEvent      *AnyEntityChangeEventModel      `tfsdk:"any_entity_change_event"`
 	TimerPropertyExpiredEvent *TimerPropertyExpiredEventModel `tfsdk:"timer_property_expired_event"`
+	RunCreatedEvent           *RunCreatedEventModel           `tfsdk:"run_created_event"`
+	RunUpdatedEvent           *RunUpdatedEventModel           `tfsdk:"run_updated_event"`
+	RunStatusChangedEvent     *RunStatusChangedEventModel     `tfsdk:"run_status_changed_event"`
+	CronEvent                 *CronEventModel                 `tfsdk:"cron_event"`
 	JqCondition               *JqConditionModel               `tfsdk:"jq_condition"`
+	RulesCondition            *RulesConditionModel            `tfsdk:"rules_condition"`
 }
 
 type KafkaMethodModel struct {
 	Payload types.String `tfsdk:"payload"`
 }
 
+type WebhookTlsModel struct {
+	Verify        types.Bool   `tfsdk:"verify"`
+	CaCertificate types.String `tfsdk:"ca_certificate"`
+}
+
 type WebhookMethodModel struct {
-	Url          types.String `tfsdk:"url"`
-	Agent        types.String `tfsdk:"agent"`
-	Synchronized types.String `tfsdk:"synchronized"`
-	Method       types.String `tfsdk:"method"`
-	Headers      types.Map    `tfsdk:"headers"`
-	Body         types.String `tfsdk:"body"`
+	Url          types.String     `tfsdk:"url"`
+	Agent        types.String     `tfsdk:"agent"`
+	Synchronized types.String     `tfsdk:"synchronized"`
+	Method       types.String     `tfsdk:"method"`
+	Headers      types.Map        `tfsdk:"headers"`
+	Body         types.String     `tfsdk:"body"`
+	Tls          *WebhookTlsModel `tfsdk:"tls"`
+	Timeout      types.Int64      `tfsdk:"timeout"`
 }
 
 type GithubMethodModel struct {
@@ -332,6 +397,9 @@ type GithubMethodModel struct {
 	Workflow             types.String `tfsdk:"workflow"`
 	WorkflowInputs       types.String `tfsdk:"workflow_inputs"`
 	ReportWorkflowStatus types.String `tfsdk:"report_workflow_status"`
+	InstallationId       types.String `tfsdk:"installation_id"`
+	OmitUserInputs       types.String `tfsdk:"omit_user_inputs"`
+	OmitPayload          types.String `tfsdk:"omit_payload"`
 }
 
 type GitlabMethodModel struct {
@@ -339,6 +407,16 @@ type GitlabMethodModel struct {
 	GroupName         types.String `tfsdk:"group_name"`
 	DefaultRef        types.String `tfsdk:"default_ref"`
 	PipelineVariables types.String `tfsdk:"pipeline_variables"`
+	Agent             types.String `tfsdk:"agent"`
+	InstallationId    types.String `tfsdk:"installation_id"`
+	OmitUserInputs    types.String `tfsdk:"omit_user_inputs"`
+	OmitPayload       types.String `tfsdk:"omit_payload"`
+}
+
+type IntegrationMethodModel struct {
+	InstallationId        types.String `tfsdk:"installation_id"`
+	IntegrationActionType types.String `tfsdk:"integration_action_type"`
+	ExecutionProperties   types.String `tfsdk:"execution_properties"`
 }
 
 type AzureMethodModel struct {
@@ -366,45 +444,102 @@ type ApprovalWebhookNotificationModel struct {
 	Format types.String `tfsdk:"format"`
 }
 
+type ApprovalSlackNotificationModel struct {
+	Channel types.String `tfsdk:"channel"`
+}
+
+type ApprovalTeamsNotificationModel struct {
+	Url types.String `tfsdk:"url"`
+}
+
+type ApprovalTimeoutModel struct {
+	Minutes    types.Int64 `tfsdk:"minutes"`
+	AutoReject types.Bool  `tfsdk:"auto_reject"`
+}
+
 type ActionModel struct {
-	ID                          types.String                      `tfsdk:"id"`
-	Identifier                  types.String                      `tfsdk:"identifier"`
-	Blueprint                   types.String                      `tfsdk:"blueprint"`
-	Title                       types.String                      `tfsdk:"title"`
-	Icon                        types.String                      `tfsdk:"icon"`
-	Description                 types.String                      `tfsdk:"description"`
-	SelfServiceTrigger          *SelfServiceTriggerModel          `tfsdk:"self_service_trigger"`
-	AutomationTrigger           *AutomationTriggerModel           `tfsdk:"automation_trigger"`
-	KafkaMethod                 *KafkaMethodModel                 `tfsdk:"kafka_method"`
-	WebhookMethod               *WebhookMethodModel               `tfsdk:"webhook_method"`
-	GithubMethod                *GithubMethodModel                `tfsdk:"github_method"`
-	GitlabMethod                *GitlabMethodModel                `tfsdk:"gitlab_method"`
-	AzureMethod                 *AzureMethodModel                 `tfsdk:"azure_method"`
-	UpsertEntityMethod          *UpsertEntityMethodModel          `tfsdk:"upsert_entity_method"`
-	RequiredApproval            types.Bool                        `tfsdk:"required_approval"`
-	ApprovalWebhookNotification *ApprovalWebhookNotificationModel `tfsdk:"approval_webhook_notification"`
-	ApprovalEmailNotification   types.Object                      `tfsdk:"approval_email_notification"`
-	Publish                     types.Bool                        `tfsdk:"publish"`
+	ID                           types.String                      `tfsdk:"id"`
+	Identifier                   types.String                      `tfsdk:"identifier"`
+	Blueprint                    types.String                      `tfsdk:"blueprint"`
+	Title                        types.String                      `tfsdk:"title"`
+	Icon                         types.String                      `tfsdk:"icon"`
+	Description                  types.String                      `tfsdk:"description"`
+	SelfServiceTrigger           *SelfServiceTriggerModel          `tfsdk:"self_service_trigger"`
+	AutomationTrigger            *AutomationTriggerModel           `tfsdk:"automation_trigger"`
+	KafkaMethod                  *KafkaMethodModel                 `tfsdk:"kafka_method"`
+	WebhookMethod                *WebhookMethodModel               `tfsdk:"webhook_method"`
+	GithubMethod                 *GithubMethodModel                `tfsdk:"github_method"`
+	GitlabMethod                 *GitlabMethodModel                `tfsdk:"gitlab_method"`
+	AzureMethod                  *AzureMethodModel                 `tfsdk:"azure_method"`
+	UpsertEntityMethod           *UpsertEntityMethodModel          `tfsdk:"upsert_entity_method"`
+	IntegrationMethod            *IntegrationMethodModel           `tfsdk:"integration_method"`
+	RequiredApproval             types.Bool                        `tfsdk:"required_approval"`
+	RequiredApprovalJqQuery      types.String                      `tfsdk:"required_approval_jq_query"`
+	RequiredApprovalMinApprovers types.Int64                       `tfsdk:"required_approval_min_approvers"`
+	ApprovalWebhookNotification  *ApprovalWebhookNotificationModel `tfsdk:"approval_webhook_notification"`
+	ApprovalEmailNotification    types.Object                      `tfsdk:"approval_email_notification"`
+	ApprovalSlackNotification    *ApprovalSlackNotificationModel   `tfsdk:"approval_slack_notification"`
+	ApprovalTeamsNotification    *ApprovalTeamsNotificationModel   `tfsdk:"approval_teams_notification"`
+	ApprovalTimeout              *ApprovalTimeoutModel             `tfsdk:"approval_timeout"`
+	Publish                      types.Bool                        `tfsdk:"publish"`
 }
 
 // ActionValidationModel is a model used for the validation of ActionModel resources
 type ActionValidationModel struct {
-	ID                          types.String `tfsdk:"id"`
-	Identifier                  types.String `tfsdk:"identifier"`
-	Blueprint                   types.String `tfsdk:"blueprint"`
-	Title                       types.String `tfsdk:"title"`
-	Icon                        types.String `tfsdk:"icon"`
-	Description                 types.String `tfsdk:"description"`
-	SelfServiceTrigger          types.Object `tfsdk:"self_service_trigger"`
-	AutomationTrigger           types.Object `tfsdk:"automation_trigger"`
-	KafkaMethod                 types.Object `tfsdk:"kafka_method"`
-	WebhookMethod               types.Object `tfsdk:"webhook_method"`
-	GithubMethod                types.Object `tfsdk:"github_method"`
-	GitlabMethod                types.Object `tfsdk:"gitlab_method"`
-	AzureMethod                 types.Object `tfsdk:"azure_method"`
-	UpsertEntityMethod          types.Object `tfsdk:"upsert_entity_method"`
-	RequiredApproval            types.Bool   `tfsdk:"required_approval"`
-	ApprovalWebhookNotification types.Object `tfsdk:"approval_webhook_notification"`
-	ApprovalEmailNotification   types.Object `tfsdk:"approval_email_notification"`
-	Publish                     types.Bool   `tfsdk:"publish"`
+	ID                           types.String `tfsdk:"id"`
+	Identifier                   types.String `tfsdk:"identifier"`
+	Blueprint                    types.String `tfsdk:"blueprint"`
+	Title                        types.String `tfsdk:"title"`
+	Icon                         types.String `tfsdk:"icon"`
+	Description                  types.String `tfsdk:"description"`
+	SelfServiceTrigger           types.Object `tfsdk:"self_service_trigger"`
+	AutomationTrigger            types.Object `tfsdk:"automation_trigger"`
+	KafkaMethod                  types.Object `tfsdk:"kafka_method"`
+	WebhookMethod                types.Object `tfsdk:"webhook_method"`
+	GithubMethod                 types.Object `tfsdk:"github_method"`
+	GitlabMethod                 types.Object `tfsdk:"gitlab_method"`
+	AzureMethod                  types.Object `tfsdk:"azure_method"`
+	UpsertEntityMethod           types.Object `tfsdk:"upsert_entity_method"`
+	IntegrationMethod            types.Object `tfsdk:"integration_method"`
+	RequiredApproval             types.Bool   `tfsdk:"required_approval"`
+	RequiredApprovalJqQuery      types.String `tfsdk:"required_approval_jq_query"`
+	RequiredApprovalMinApprovers types.Int64  `tfsdk:"required_approval_min_approvers"`
+	ApprovalWebhookNotification  types.Object `tfsdk:"approval_webhook_notification"`
+	ApprovalEmailNotification    types.Object `tfsdk:"approval_email_notification"`
+	ApprovalSlackNotification    types.Object `tfsdk:"approval_slack_notification"`
+	ApprovalTeamsNotification    types.Object `tfsdk:"approval_teams_notification"`
+	ApprovalTimeout              types.Object `tfsdk:"approval_timeout"`
+	Publish                      types.Bool   `tfsdk:"publish"`
+}
+
+type ActionsDataModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	Blueprint            types.String   `tfsdk:"blueprint"`
+	TriggerType          types.String   `tfsdk:"trigger_type"`
+	InvocationMethodType types.String   `tfsdk:"invocation_method_type"`
+	Identifiers          []types.String `tfsdk:"identifiers"`
+	Actions              []ActionModel  `tfsdk:"actions"`
+}
+
+type FormFieldModel struct {
+	Identifier types.String `tfsdk:"identifier"`
+	Title      types.String `tfsdk:"title"`
+	Type       types.String `tfsdk:"type"`
+	Step       types.String `tfsdk:"step"`
+	Visible    types.Bool   `tfsdk:"visible"`
+	Required   types.Bool   `tfsdk:"required"`
+	Default    types.String `tfsdk:"default"`
+	Enum       types.String `tfsdk:"enum"`
+	Dataset    types.String `tfsdk:"dataset"`
+}
+
+type FormPreviewDataModel struct {
+	ID               types.String     `tfsdk:"id"`
+	ActionIdentifier types.String     `tfsdk:"action_identifier"`
+	UserInputsJSON   types.String     `tfsdk:"user_inputs_json"`
+	Entity           types.String     `tfsdk:"entity"`
+	User             types.String     `tfsdk:"user"`
+	Form             types.String     `tfsdk:"form"`
+	Fields           []FormFieldModel `tfsdk:"fields"`
+	VisibleFields    []types.String   `tfsdk:"visible_fields"`
 }
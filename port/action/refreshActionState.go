@@ -49,6 +49,18 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 			Method:       flex.GoStringToFramework(a.InvocationMethod.Method),
 			Headers:      headers,
 			Body:         body,
+			Timeout:      types.Int64PointerValue(a.InvocationMethod.Timeout),
+		}
+
+		if a.InvocationMethod.Tls != nil {
+			verify := true
+			if a.InvocationMethod.Tls.Verify != nil {
+				verify = *a.InvocationMethod.Tls.Verify
+			}
+			state.WebhookMethod.Tls = &WebhookTlsModel{
+				Verify:        types.BoolValue(verify),
+				CaCertificate: flex.GoStringToFramework(a.InvocationMethod.Tls.CaCertificate),
+			}
 		}
 	}
 
@@ -62,12 +74,24 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 			return err
 		}
 
+		omitUserInputs, err := utils.GoObjectToTerraformString(a.InvocationMethod.OmitUserInputs)
+		if err != nil {
+			return err
+		}
+		omitPayload, err := utils.GoObjectToTerraformString(a.InvocationMethod.OmitPayload)
+		if err != nil {
+			return err
+		}
+
 		state.GithubMethod = &GithubMethodModel{
 			Org:                  types.StringValue(*a.InvocationMethod.Org),
 			Repo:                 types.StringValue(*a.InvocationMethod.Repo),
 			Workflow:             types.StringValue(*a.InvocationMethod.Workflow),
 			WorkflowInputs:       workflowInputs,
 			ReportWorkflowStatus: reportWorkflowStatus,
+			InstallationId:       flex.GoStringToFramework(a.InvocationMethod.InstallationId),
+			OmitUserInputs:       omitUserInputs,
+			OmitPayload:          omitPayload,
 		}
 	}
 
@@ -77,11 +101,28 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 			return err
 		}
 
+		agent, err := utils.GoObjectToTerraformString(a.InvocationMethod.Agent)
+		if err != nil {
+			return err
+		}
+		omitUserInputs, err := utils.GoObjectToTerraformString(a.InvocationMethod.OmitUserInputs)
+		if err != nil {
+			return err
+		}
+		omitPayload, err := utils.GoObjectToTerraformString(a.InvocationMethod.OmitPayload)
+		if err != nil {
+			return err
+		}
+
 		state.GitlabMethod = &GitlabMethodModel{
 			ProjectName:       types.StringValue(*a.InvocationMethod.ProjectName),
 			GroupName:         types.StringValue(*a.InvocationMethod.GroupName),
 			DefaultRef:        flex.GoStringToFramework(a.InvocationMethod.DefaultRef),
 			PipelineVariables: pipelineVariables,
+			Agent:             agent,
+			InstallationId:    flex.GoStringToFramework(a.InvocationMethod.InstallationId),
+			OmitUserInputs:    omitUserInputs,
+			OmitPayload:       omitPayload,
 		}
 	}
 
@@ -131,10 +172,78 @@ func writeInvocationMethodToResource(ctx context.Context, a *cli.Action, state *
 		}
 	}
 
+	if a.InvocationMethod.Type == consts.IntegrationAction {
+		executionProperties, err := utils.GoObjectToTerraformString(a.InvocationMethod.IntegrationActionExecutionProperties)
+		if err != nil {
+			return err
+		}
+
+		state.IntegrationMethod = &IntegrationMethodModel{
+			InstallationId:        flex.GoStringToFramework(a.InvocationMethod.InstallationId),
+			IntegrationActionType: flex.GoStringToFramework(a.InvocationMethod.IntegrationActionType),
+			ExecutionProperties:   executionProperties,
+		}
+	}
+
 	return nil
 }
 
-func writeDatasetToResource(ds *cli.Dataset) *DatasetModel {
+// writeDatasetValueToResource reads a dataset rule value, which is either a jq query or a literal. Literals are read
+// into the field of their type, and literals none of the typed fields can hold are kept as JSON.
+func writeDatasetValueToResource(ctx context.Context, value any) *Value {
+	if value == nil {
+		return nil
+	}
+
+	datasetValue := &Value{
+		JqQuery:       types.StringNull(),
+		Literal:       types.StringNull(),
+		NumberLiteral: types.Float64Null(),
+		BoolLiteral:   types.BoolNull(),
+		LiteralList:   types.ListNull(types.StringType),
+		LiteralJSON:   types.StringNull(),
+	}
+
+	switch v := value.(type) {
+	case string:
+		datasetValue.Literal = types.StringValue(v)
+		return datasetValue
+	case float64:
+		datasetValue.NumberLiteral = types.Float64Value(v)
+		return datasetValue
+	case bool:
+		datasetValue.BoolLiteral = types.BoolValue(v)
+		return datasetValue
+	case map[string]any:
+		if jqQuery, ok := v["jqQuery"].(string); ok && len(v) == 1 {
+			datasetValue.JqQuery = types.StringValue(jqQuery)
+			return datasetValue
+		}
+	case []any:
+		if literals, ok := stringLiterals(v); ok {
+			datasetValue.LiteralList = flex.GoArrayStringToTerraformList(ctx, literals)
+			return datasetValue
+		}
+	}
+
+	b, _ := json.Marshal(value)
+	datasetValue.LiteralJSON = types.StringValue(string(b))
+	return datasetValue
+}
+
+func stringLiterals(values []any) ([]string, bool) {
+	literals := make([]string, 0, len(values))
+	for _, item := range values {
+		s, ok := item.(string)
+		if !ok {
+			return nil, false
+		}
+		literals = append(literals, s)
+	}
+	return literals, true
+}
+
+func writeDatasetToResource(ctx context.Context, ds *cli.Dataset) *DatasetModel {
 	if ds == nil {
 		return nil
 	}
@@ -145,18 +254,32 @@ func writeDatasetToResource(ds *cli.Dataset) *DatasetModel {
 
 	for _, v := range ds.Rules {
 		rule := &Rule{
-			Blueprint: flex.GoStringToFramework(v.Blueprint),
-			Property:  flex.GoStringToFramework(v.Property),
-			Operator:  flex.GoStringToFramework(&v.Operator),
-			Value: &Value{
-				JqQuery: flex.GoStringToFramework(&v.Value.JqQuery),
-			},
+			Blueprint:  flex.GoStringToFramework(v.Blueprint),
+			Property:   flex.GoStringToFramework(v.Property),
+			Direction:  flex.GoStringToFramework(v.Direction),
+			Combinator: flex.GoStringToFramework(v.Combinator),
+			Operator:   types.StringNull(),
+			Value:      writeDatasetValueToResource(ctx, v.Value),
+		}
+		if v.Operator != "" {
+			rule.Operator = types.StringValue(v.Operator)
+		}
+		if v.Combinator != nil {
+			rule.Rules = make([]GroupRule, 0, len(v.Rules))
+			for _, groupRule := range v.Rules {
+				rule.Rules = append(rule.Rules, GroupRule{
+					Blueprint: flex.GoStringToFramework(groupRule.Blueprint),
+					Property:  flex.GoStringToFramework(groupRule.Property),
+					Operator:  flex.GoStringToFramework(&groupRule.Operator),
+					Direction: flex.GoStringToFramework(groupRule.Direction),
+					Value:     writeDatasetValueToResource(ctx, groupRule.Value),
+				})
+			}
 		}
 		datasetModel.Rules = append(datasetModel.Rules, *rule)
 	}
 
 	return datasetModel
-
 }
 
 func writeVisibleToResource(v cli.ActionProperty) (types.Bool, types.String) {
@@ -310,6 +433,29 @@ func buildUserProperties(ctx context.Context, a *cli.Action) (*UserPropertiesMod
 	return properties, nil
 }
 
+// userInputsToJSON returns the user inputs of the action as JSON. When the user inputs in the state are semantically
+// equal to the ones of the action, the state value is kept, so formatting differences don't show as drift.
+func userInputsToJSON(a *cli.Action, stateUserInputs types.String) (types.String, error) {
+	var userInputs any = a.Trigger.RawUserInputs
+	if a.Trigger.RawUserInputs == nil {
+		userInputs = a.Trigger.UserInputs
+	}
+	remote, err := json.Marshal(userInputs)
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	if !stateUserInputs.IsNull() && !stateUserInputs.IsUnknown() {
+		var stateValue, remoteValue any
+		if json.Unmarshal([]byte(stateUserInputs.ValueString()), &stateValue) == nil &&
+			json.Unmarshal(remote, &remoteValue) == nil && reflect.DeepEqual(stateValue, remoteValue) {
+			return stateUserInputs, nil
+		}
+	}
+
+	return types.StringValue(string(remote)), nil
+}
+
 func writeTriggerToResource(ctx context.Context, a *cli.Action, state *ActionModel) error {
 	if a.Trigger.Type == consts.SelfService {
 		userProperties, err := buildUserProperties(ctx, a)
@@ -322,12 +468,36 @@ func writeTriggerToResource(ctx context.Context, a *cli.Action, state *ActionMod
 			orderProperties = flex.GoArrayStringToTerraformList(ctx, a.Trigger.UserInputs.Order)
 		}
 
+		userInputsJSON := types.StringNull()
+		if state.SelfServiceTrigger != nil && !state.SelfServiceTrigger.UserInputsJSON.IsNull() {
+			// the user inputs are managed as JSON, the typed fields are left empty
+			userInputsJSON, err = userInputsToJSON(a, state.SelfServiceTrigger.UserInputsJSON)
+			if err != nil {
+				return err
+			}
+			userProperties = &UserPropertiesModel{}
+			requiredJqQuery = types.StringNull()
+			orderProperties = types.ListNull(types.StringType)
+		}
+
+		var steps []StepModel
+		if userInputsJSON.IsNull() {
+			for _, step := range a.Trigger.UserInputs.Steps {
+				steps = append(steps, StepModel{
+					Title: types.StringValue(step.Title),
+					Order: flex.GoArrayStringToTerraformList(ctx, step.Order),
+				})
+			}
+		}
+
 		state.SelfServiceTrigger = &SelfServiceTriggerModel{
 			BlueprintIdentifier: flex.GoStringToFramework(a.Trigger.BlueprintIdentifier),
 			Operation:           types.StringValue(*a.Trigger.Operation),
 			UserProperties:      userProperties,
 			RequiredJqQuery:     requiredJqQuery,
 			OrderProperties:     orderProperties,
+			UserInputsJSON:      userInputsJSON,
+			Steps:               steps,
 		}
 
 		if a.Trigger.Condition != nil {
@@ -343,7 +513,19 @@ func writeTriggerToResource(ctx context.Context, a *cli.Action, state *ActionMod
 		automationTrigger := &AutomationTriggerModel{}
 
 		var expressions []types.String
-		if a.Trigger.Condition != nil {
+		if a.Trigger.Condition != nil && a.Trigger.Condition.Type == consts.SearchCondition {
+			automationTrigger.RulesCondition = &RulesConditionModel{
+				Combinator: flex.GoStringToFramework(a.Trigger.Condition.Combinator),
+				Rules:      make([]ConditionRuleModel, 0, len(a.Trigger.Condition.Rules)),
+			}
+			for _, r := range a.Trigger.Condition.Rules {
+				rule, ok := r.(map[string]any)
+				if !ok {
+					return fmt.Errorf("invalid rule in the condition of the automation trigger: %v", r)
+				}
+				automationTrigger.RulesCondition.Rules = append(automationTrigger.RulesCondition.Rules, writeConditionRuleToResource(rule))
+			}
+		} else if a.Trigger.Condition != nil {
 			for _, e := range a.Trigger.Condition.Expressions {
 				expressions = append(expressions, types.StringValue(e))
 			}
@@ -384,12 +566,65 @@ func writeTriggerToResource(ctx context.Context, a *cli.Action, state *ActionMod
 			}
 		}
 
+		if a.Trigger.Event.Type == consts.RunCreated {
+			automationTrigger.RunCreatedEvent = &RunCreatedEventModel{
+				ActionIdentifier: flex.GoStringToFramework(a.Trigger.Event.ActionIdentifier),
+			}
+		}
+
+		if a.Trigger.Event.Type == consts.RunUpdated {
+			automationTrigger.RunUpdatedEvent = &RunUpdatedEventModel{
+				ActionIdentifier: flex.GoStringToFramework(a.Trigger.Event.ActionIdentifier),
+			}
+		}
+
+		if a.Trigger.Event.Type == consts.RunStatusChanged {
+			automationTrigger.RunStatusChangedEvent = &RunStatusChangedEventModel{
+				ActionIdentifier: flex.GoStringToFramework(a.Trigger.Event.ActionIdentifier),
+				Status:           flex.GoStringToFramework(a.Trigger.Event.Status),
+			}
+		}
+
+		if a.Trigger.Event.Type == consts.Cron {
+			automationTrigger.CronEvent = &CronEventModel{
+				Cron: flex.GoStringToFramework(a.Trigger.Event.Cron),
+			}
+		}
+
 		state.AutomationTrigger = automationTrigger
 	}
 
 	return nil
 }
 
+func writeConditionRuleToResource(rule map[string]any) ConditionRuleModel {
+	property, _ := rule["property"].(string)
+	operator, _ := rule["operator"].(string)
+	model := ConditionRuleModel{
+		Property:    types.StringValue(property),
+		Operator:    types.StringValue(operator),
+		Value:       types.StringNull(),
+		NumberValue: types.Float64Null(),
+		BoolValue:   types.BoolNull(),
+	}
+
+	switch v := rule["value"].(type) {
+	case string:
+		model.Value = types.StringValue(v)
+	case float64:
+		model.NumberValue = types.Float64Value(v)
+	case bool:
+		model.BoolValue = types.BoolValue(v)
+	case []any:
+		model.Values = make([]types.String, 0, len(v))
+		for _, item := range v {
+			model.Values = append(model.Values, types.StringValue(fmt.Sprint(item)))
+		}
+	}
+
+	return model
+}
+
 func refreshActionState(ctx context.Context, state *ActionModel, a *cli.Action) error {
 	state.ID = types.StringValue(a.Identifier)
 	state.Identifier = types.StringValue(a.Identifier)
@@ -408,11 +643,20 @@ func refreshActionState(ctx context.Context, state *ActionModel, a *cli.Action)
 		return err
 	}
 
-	state.RequiredApproval = flex.GoBoolToFramework(a.RequiredApproval)
+	writeRequiredApprovalToResource(a, state)
 	if a.ApprovalNotification != nil {
-		if a.ApprovalNotification.Type == "email" {
+		switch a.ApprovalNotification.Type {
+		case "email":
 			state.ApprovalEmailNotification, _ = types.ObjectValue(nil, nil)
-		} else {
+		case "slack":
+			state.ApprovalSlackNotification = &ApprovalSlackNotificationModel{
+				Channel: flex.GoStringToFramework(a.ApprovalNotification.Channel),
+			}
+		case "msTeams":
+			state.ApprovalTeamsNotification = &ApprovalTeamsNotificationModel{
+				Url: types.StringValue(a.ApprovalNotification.Url),
+			}
+		default:
 			state.ApprovalWebhookNotification = &ApprovalWebhookNotificationModel{
 				Url: types.StringValue(a.ApprovalNotification.Url),
 			}
@@ -423,11 +667,37 @@ func refreshActionState(ctx context.Context, state *ActionModel, a *cli.Action)
 
 		}
 	}
+	if a.ApprovalTimeout != nil {
+		state.ApprovalTimeout = &ApprovalTimeoutModel{
+			Minutes:    types.Int64Value(a.ApprovalTimeout.Minutes),
+			AutoReject: types.BoolValue(a.ApprovalTimeout.AutoReject),
+		}
+	}
 	state.Publish = flex.GoBoolToFramework(a.Publish)
 
 	return nil
 }
 
+// writeRequiredApprovalToResource writes the required approval of the action, which is either a bool, a jq query or a
+// minimum number of approvers
+func writeRequiredApprovalToResource(a *cli.Action, state *ActionModel) {
+	state.RequiredApproval = types.BoolNull()
+	state.RequiredApprovalJqQuery = types.StringNull()
+	state.RequiredApprovalMinApprovers = types.Int64Null()
+
+	switch v := a.RequiredApproval.(type) {
+	case bool:
+		state.RequiredApproval = types.BoolValue(v)
+	case map[string]any:
+		if jqQuery, ok := v["jqQuery"].(string); ok {
+			state.RequiredApprovalJqQuery = types.StringValue(jqQuery)
+		}
+		if minApprovers, ok := v["minApprovers"].(float64); ok {
+			state.RequiredApprovalMinApprovers = types.Int64Value(int64(minApprovers))
+		}
+	}
+}
+
 func setCommonProperties(ctx context.Context, v cli.ActionProperty, prop interface{}) error {
 	properties := []string{"Description", "Icon", "Default", "Title", "DependsOn", "Dataset", "Visible"}
 	for _, property := range properties {
@@ -227,6 +227,75 @@ func TestAccPortActionWebhookInvocation(t *testing.T) {
 		},
 	})
 }
+func TestAccPortActionWebhookSecretReferences(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	secretName := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_secret" "token" {
+		name = "%s"
+		value = "token-value"
+	}
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		webhook_method = {
+			url = "https://example.com"
+			headers = {"Authorization": "Bearer {{ .secrets[\"${port_secret.token.name}\"] }}"}
+			body = jsonencode({"runId": "{{ .run.id }}", "token": "{{ .secrets[\"${port_secret.token.name}\"] }}"})
+		}
+	}`, secretName, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "webhook_method.headers.Authorization", fmt.Sprintf("Bearer {{ .secrets[\"%s\"] }}", secretName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionInvalidSecretReference(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		webhook_method = {
+			url = "https://example.com"
+			headers = {"Authorization": "Bearer {{ .secrets }}"}
+		}
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
+				ExpectError: regexp.MustCompile(`invalid secret reference`),
+			},
+		},
+	})
+}
+
 func TestAccPortActionWebhookSyncInvocation(t *testing.T) {
 	identifier := utils.GenID()
 	actionIdentifier := utils.GenID()
@@ -358,6 +427,192 @@ func TestAccPortActionGitlabInvocation(t *testing.T) {
 		},
 	})
 }
+func TestAccPortActionGithubAppOptions(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		github_method = {
+			org = "port",
+			repo = "terraform-provider-port",
+			workflow = "main.yml"
+			installation_id = "12345"
+			omit_user_inputs = true
+			omit_payload = false
+		}
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "github_method.installation_id", "12345"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "github_method.omit_user_inputs", "true"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "github_method.omit_payload", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionGitlabAppOptions(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		gitlab_method = {
+			project_name = "terraform-provider-port"
+			group_name = "port"
+			installation_id = "67890"
+			omit_user_inputs = false
+			omit_payload = true
+		}
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "gitlab_method.installation_id", "67890"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "gitlab_method.omit_user_inputs", "false"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "gitlab_method.omit_payload", "true"),
+					resource.TestCheckNoResourceAttr("port_action.create_microservice", "gitlab_method.agent"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionGitlabAgent(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		gitlab_method = {
+			project_name = "terraform-provider-port"
+			group_name = "port"
+			agent = true
+		}
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "gitlab_method.agent", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionWebhookTlsAndTimeout(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		webhook_method = {
+			url = "https://example.com"
+			timeout = 30
+			tls = {
+				verify = false
+			}
+		}
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "webhook_method.timeout", "30"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "webhook_method.tls.verify", "false"),
+					resource.TestCheckNoResourceAttr("port_action.create_microservice", "webhook_method.tls.ca_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionIntegrationInvocation(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		integration_method = {
+			installation_id = "my-ocean-integration"
+			integration_action_type = "dispatch_workflow"
+			execution_properties = jsonencode({"org": "port", "repo": "terraform-provider-port", "workflow": "main.yml"})
+		}
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "integration_method.installation_id", "my-ocean-integration"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "integration_method.integration_action_type", "dispatch_workflow"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "integration_method.execution_properties", "{\"org\":\"port\",\"repo\":\"terraform-provider-port\",\"workflow\":\"main.yml\"}"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPortActionAzureInvocation(t *testing.T) {
 	identifier := utils.GenID()
 	actionIdentifier := utils.GenID()
@@ -1567,24 +1822,19 @@ func TestAccPortAutomationTimerPropertyExpired(t *testing.T) {
 	})
 }
 
-func TestAccPortWebhookApproval(t *testing.T) {
-	identifier := utils.GenID()
+func TestAccPortAutomationCron(t *testing.T) {
 	actionIdentifier := utils.GenID()
-	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
 		identifier = "%s"
 		icon = "Terraform"
-		self_service_trigger = {
-			operation = "DAY-2"
-			blueprint_identifier = port_blueprint.microservice.identifier
+		automation_trigger = {
+			cron_event = {
+				cron = "0 9 * * 1-5"
+			}
 		}
 		kafka_method = {}
-		required_approval = true
-		approval_webhook_notification = {
-			url = "https://example.com"
-			format = "json"
-		}
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
@@ -1593,65 +1843,352 @@ func TestAccPortWebhookApproval(t *testing.T) {
 			{
 				Config: acctest.ProviderConfig + testAccActionConfigCreate,
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("port_action.create_microservice", "title", "TF Provider Test"),
 					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "icon", "Terraform"),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.blueprint_identifier", identifier),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.operation", "DAY-2"),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "required_approval", "true"),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_webhook_notification.url", "https://example.com"),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_webhook_notification.format", "json"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "automation_trigger.cron_event.cron", "0 9 * * 1-5"),
 				),
 			},
 		},
 	})
 }
 
-func TestAccPortEmailApproval(t *testing.T) {
-	identifier := utils.GenID()
+func TestAccPortAutomationInvalidCron(t *testing.T) {
 	actionIdentifier := utils.GenID()
-	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	var testAccActionConfigCreate = fmt.Sprintf(`
 	resource "port_action" "create_microservice" {
 		title = "TF Provider Test"
 		identifier = "%s"
 		icon = "Terraform"
-		self_service_trigger = {
-			operation = "DAY-2"
-			blueprint_identifier = port_blueprint.microservice.identifier
+		automation_trigger = {
+			cron_event = {
+				cron = "every day"
+			}
 		}
 		kafka_method = {}
-		required_approval = true
-		approval_email_notification = {}
 	}`, actionIdentifier)
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: acctest.ProviderConfig + testAccActionConfigCreate,
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr("port_action.create_microservice", "title", "TF Provider Test"),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "icon", "Terraform"),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.blueprint_identifier", identifier),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.operation", "DAY-2"),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "required_approval", "true"),
-					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_email_notification.%", "0"),
-				),
+				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
+				ExpectError: regexp.MustCompile(`must be a cron expression`),
 			},
 		},
 	})
 }
 
-func TestAccPortActionStringGitlabMethodSetConditionally(t *testing.T) {
+func TestAccPortAutomationRunEvents(t *testing.T) {
 	identifier := utils.GenID()
 	actionIdentifier := utils.GenID()
+	runCreatedIdentifier := utils.GenID()
+	runUpdatedIdentifier := utils.GenID()
+	runStatusChangedIdentifier := utils.GenID()
 	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
-	resource "port_action" "action1" {
-	  title             = "Action 1"
-	  identifier        = "%s"
-	  required_approval = false
-	  self_service_trigger = {
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "CREATE"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		kafka_method = {}
+	}
+	resource "port_action" "run_created" {
+		title = "TF Provider Test Run Created"
+		identifier = "%s"
+		icon = "Terraform"
+		automation_trigger = {
+			run_created_event = {
+				action_identifier = port_action.create_microservice.identifier
+			}
+		}
+		kafka_method = {}
+	}
+	resource "port_action" "run_updated" {
+		title = "TF Provider Test Run Updated"
+		identifier = "%s"
+		icon = "Terraform"
+		automation_trigger = {
+			run_updated_event = {
+				action_identifier = port_action.create_microservice.identifier
+			}
+		}
+		kafka_method = {}
+	}
+	resource "port_action" "run_status_changed" {
+		title = "TF Provider Test Run Status Changed"
+		identifier = "%s"
+		icon = "Terraform"
+		automation_trigger = {
+			run_status_changed_event = {
+				action_identifier = port_action.create_microservice.identifier
+				status = "FAILURE"
+			}
+		}
+		kafka_method = {}
+	}`, actionIdentifier, runCreatedIdentifier, runUpdatedIdentifier, runStatusChangedIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.run_created", "automation_trigger.run_created_event.action_identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.run_updated", "automation_trigger.run_updated_event.action_identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.run_status_changed", "automation_trigger.run_status_changed_event.action_identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.run_status_changed", "automation_trigger.run_status_changed_event.status", "FAILURE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortAutomationRulesCondition(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		automation_trigger = {
+			entity_updated_event = {
+				blueprint_identifier = port_blueprint.microservice.identifier
+			}
+			rules_condition = {
+				combinator = "or"
+				rules = [
+					{
+						property = "$title"
+						operator = "="
+						value = "Test"
+					},
+					{
+						property = "$identifier"
+						operator = "in"
+						values = ["a", "b"]
+					}
+				]
+			}
+		}
+		kafka_method = {}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "automation_trigger.entity_updated_event.blueprint_identifier", identifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "automation_trigger.rules_condition.combinator", "or"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "automation_trigger.rules_condition.rules.#", "2"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "automation_trigger.rules_condition.rules.0.property", "$title"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "automation_trigger.rules_condition.rules.0.value", "Test"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "automation_trigger.rules_condition.rules.1.operator", "in"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "automation_trigger.rules_condition.rules.1.values.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortWebhookApproval(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		kafka_method = {}
+		required_approval = true
+		approval_webhook_notification = {
+			url = "https://example.com"
+			format = "json"
+		}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "title", "TF Provider Test"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "icon", "Terraform"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.blueprint_identifier", identifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.operation", "DAY-2"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "required_approval", "true"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_webhook_notification.url", "https://example.com"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_webhook_notification.format", "json"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortEmailApproval(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		kafka_method = {}
+		required_approval = true
+		approval_email_notification = {}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "title", "TF Provider Test"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "icon", "Terraform"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.blueprint_identifier", identifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.operation", "DAY-2"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "required_approval", "true"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_email_notification.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortSlackApprovalWithTimeout(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		kafka_method = {}
+		required_approval_min_approvers = 2
+		approval_slack_notification = {
+			channel = "#approvals"
+		}
+		approval_timeout = {
+			minutes = 60
+			auto_reject = true
+		}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckNoResourceAttr("port_action.create_microservice", "required_approval"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "required_approval_min_approvers", "2"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_slack_notification.channel", "#approvals"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_timeout.minutes", "60"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_timeout.auto_reject", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortTeamsApprovalWithJqQuery(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		kafka_method = {}
+		required_approval_jq_query = ".entity.properties.timer != null"
+		approval_teams_notification = {
+			url = "https://example.webhook.office.com/webhook"
+		}
+		approval_timeout = {
+			minutes = 30
+		}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "required_approval_jq_query", ".entity.properties.timer != null"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_teams_notification.url", "https://example.webhook.office.com/webhook"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_timeout.minutes", "30"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "approval_timeout.auto_reject", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortApprovalTimeoutWithoutRequiredApproval(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		kafka_method = {}
+		approval_timeout = {
+			minutes = 30
+		}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
+				ExpectError: regexp.MustCompile(`approval timeout without required approval`),
+			},
+		},
+	})
+}
+
+func TestAccPortActionStringGitlabMethodSetConditionally(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "action1" {
+	  title             = "Action 1"
+	  identifier        = "%s"
+	  required_approval = false
+	  self_service_trigger = {
 		operation = "CREATE"
 		blueprint_identifier = port_blueprint.microservice.identifier
 		user_properties = {}
@@ -2024,3 +2561,361 @@ func TestAccPortActionConditionalTrigger(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortActionUserInputsJSON(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "CREATE"
+			blueprint_identifier = port_blueprint.microservice.identifier
+			user_inputs_json = jsonencode({
+				properties = {
+					name = {
+						type = "string"
+						title = "Name"
+					}
+					replicas = {
+						type = "number"
+						title = "Replicas"
+						default = 1
+					}
+				}
+				required = ["name"]
+				order = ["replicas", "name"]
+			})
+		}
+		kafka_method = {}
+	}`, actionIdentifier)
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "identifier", actionIdentifier),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.operation", "CREATE"),
+					resource.TestCheckResourceAttrSet("port_action.create_microservice", "self_service_trigger.user_inputs_json"),
+					resource.TestCheckNoResourceAttr("port_action.create_microservice", "self_service_trigger.order_properties"),
+					resource.TestCheckNoResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props"),
+				),
+			},
+			{
+				// the user inputs are compared semantically, so the same inputs in a different order aren't a change
+				Config:   acctest.ProviderConfig + testAccActionConfigCreate,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPortActionSteps(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "CREATE"
+			blueprint_identifier = port_blueprint.microservice.identifier
+			user_properties = {
+				string_props = {
+					name = {
+						title = "Name"
+					}
+					region = {
+						title = "Region"
+					}
+				}
+				number_props = {
+					replicas = {
+						title = "Replicas"
+					}
+				}
+			}
+			steps = [
+				{
+					title = "General"
+					order = ["name", "region"]
+				},
+				{
+					title = "Scaling"
+					order = ["replicas"]
+				}
+			]
+			order_properties = ["name", "region", "replicas"]
+		}
+		kafka_method = {}
+	}`, actionIdentifier)
+
+	var testAccActionConfigInvalidStep = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "CREATE"
+			blueprint_identifier = port_blueprint.microservice.identifier
+			user_properties = {
+				string_props = {
+					name = {
+						title = "Name"
+					}
+				}
+			}
+			steps = [
+				{
+					title = "General"
+					order = ["name", "region"]
+				}
+			]
+		}
+		kafka_method = {}
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfigInvalidStep,
+				ExpectError: regexp.MustCompile("unknown user property"),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.steps.#", "2"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.steps.0.title", "General"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.steps.0.order.#", "2"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.steps.0.order.0", "name"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.steps.0.order.1", "region"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.steps.1.title", "Scaling"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.steps.1.order.0", "replicas"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.order_properties.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionInvalidJqTemplates(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	testAccActionConfig := func(payload string) string {
+		return testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "CREATE"
+			blueprint_identifier = port_blueprint.microservice.identifier
+			user_properties = {
+				string_props = {
+					name = {
+						title = "Name"
+					}
+				}
+			}
+		}
+		kafka_method = {
+			payload = %s
+		}
+	}`, actionIdentifier, payload)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfig(`"{\"runId\": "`),
+				ExpectError: regexp.MustCompile("invalid JSON"),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfig(`jsonencode({"name": "{{ .inputs.name | }}"})`),
+				ExpectError: regexp.MustCompile("invalid jq template"),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfig(`jsonencode({"name": "{{ .inputs.name }}", "runId": "{{ .run.id }}"})`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "kafka_method.payload", "{\"name\":\"{{ .inputs.name }}\",\"runId\":\"{{ .run.id }}\"}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionDatasetGroupsAndLiterals(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF test microservice"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			number_props = {
+				"replicas" = {
+					title = "Replicas"
+				}
+			}
+			boolean_props = {
+				"critical" = {
+					title = "Critical"
+				}
+			}
+		}
+	}
+
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+			user_properties = {
+				string_props = {
+					microservice = {
+						title     = "Microservice"
+						format    = "entity"
+						blueprint = port_blueprint.microservice.identifier
+						dataset = {
+							combinator = "and"
+							rules = [
+								{
+									property = "$title"
+									operator = "contains"
+									value = {
+										literal = "service"
+									}
+								},
+								{
+									combinator = "or"
+									rules = [
+										{
+											property = "$team"
+											operator = "containsAny"
+											value = {
+												jq_query = ".user.teams | map(.name)"
+											}
+										},
+										{
+											property = "$identifier"
+											operator = "in"
+											value = {
+												literal_list = ["payments", "billing"]
+											}
+										},
+										{
+											property = "replicas"
+											operator = ">"
+											value = {
+												number_literal = 2
+											}
+										},
+										{
+											property = "critical"
+											operator = "="
+											value = {
+												bool_literal = true
+											}
+										}
+									]
+								}
+							]
+						}
+					}
+				}
+			}
+		}
+		kafka_method = {}
+	}`, identifier, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.#", "2"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.0.value.literal", "service"),
+					resource.TestCheckNoResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.0.value.jq_query"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.combinator", "or"),
+					resource.TestCheckNoResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.operator"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.rules.#", "4"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.rules.0.value.jq_query", ".user.teams | map(.name)"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.rules.1.operator", "in"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.rules.1.value.literal_list.#", "2"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.rules.1.value.literal_list.0", "payments"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.rules.2.value.number_literal", "2"),
+					resource.TestCheckNoResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.rules.2.value.literal"),
+					resource.TestCheckResourceAttr("port_action.create_microservice", "self_service_trigger.user_properties.string_props.microservice.dataset.rules.1.rules.3.value.bool_literal", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortActionDatasetInvalidGroup(t *testing.T) {
+	identifier := utils.GenID()
+	actionIdentifier := utils.GenID()
+	var testAccActionConfigCreate = testAccCreateBlueprintConfig(identifier) + fmt.Sprintf(`
+	resource "port_action" "create_microservice" {
+		title = "TF Provider Test"
+		identifier = "%s"
+		icon = "Terraform"
+		self_service_trigger = {
+			operation = "DAY-2"
+			blueprint_identifier = port_blueprint.microservice.identifier
+			user_properties = {
+				string_props = {
+					microservice = {
+						title     = "Microservice"
+						format    = "entity"
+						blueprint = port_blueprint.microservice.identifier
+						dataset = {
+							combinator = "and"
+							rules = [
+								{
+									combinator = "or"
+									operator   = "contains"
+									rules = [
+										{
+											property = "$title"
+											operator = "contains"
+											value = {
+												literal = "service"
+											}
+										}
+									]
+								}
+							]
+						}
+					}
+				}
+			}
+		}
+		kafka_method = {}
+	}`, actionIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfigCreate,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
@@ -3,6 +3,7 @@ package action
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
@@ -10,6 +11,7 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
@@ -20,11 +22,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
+var cronRegex = regexp.MustCompile(`^\S+( \S+){4}$`)
+
 func MetadataProperties() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"title": schema.StringAttribute{
@@ -129,6 +136,42 @@ func ActionSchema() map[string]schema.Attribute {
 					Optional:            true,
 					ElementType:         types.StringType,
 				},
+				"steps": schema.ListNestedAttribute{
+					MarkdownDescription: "The steps of a multi-step form, every step is a page of the form with its own title and properties. Every step must list declared user properties, and when `order_properties` is set it must list the properties in the order of the steps",
+					Optional:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"title": schema.StringAttribute{
+								MarkdownDescription: "The title of the step",
+								Required:            true,
+							},
+							"order": schema.ListAttribute{
+								MarkdownDescription: "The properties of the step, in the order they are shown",
+								Required:            true,
+								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+									listvalidator.UniqueValues(),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+				},
+				"user_inputs_json": schema.StringAttribute{
+					MarkdownDescription: "The user inputs of the action as a JSON object with `properties`, `required`, `order` and `steps`, as exported from Port. It is sent to Port as is and compared to the user inputs in Port semantically. This can't be set at the same time as `user_properties`, `required_jq_query`, `order_properties` and `steps`",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(
+							path.MatchRelative().AtParent().AtName("user_properties"),
+							path.MatchRelative().AtParent().AtName("required_jq_query"),
+							path.MatchRelative().AtParent().AtName("order_properties"),
+							path.MatchRelative().AtParent().AtName("steps"),
+						),
+					},
+				},
 				"condition": schema.StringAttribute{
 					MarkdownDescription: "The `condition` field allows you to define rules using Port's [search & query syntax](https://docs.getport.io/search-and-query/#rules) to determine which entities the action will be available for.",
 					Optional:            true,
@@ -161,6 +204,10 @@ func ActionSchema() map[string]schema.Attribute {
 							path.MatchRelative().AtParent().AtName("entity_deleted_event"),
 							path.MatchRelative().AtParent().AtName("any_entity_change_event"),
 							path.MatchRelative().AtParent().AtName("timer_property_expired_event"),
+							path.MatchRelative().AtParent().AtName("run_created_event"),
+							path.MatchRelative().AtParent().AtName("run_updated_event"),
+							path.MatchRelative().AtParent().AtName("run_status_changed_event"),
+							path.MatchRelative().AtParent().AtName("cron_event"),
 						),
 					},
 				},
@@ -208,6 +255,128 @@ func ActionSchema() map[string]schema.Attribute {
 						},
 					},
 				},
+				"run_created_event": schema.SingleNestedAttribute{
+					MarkdownDescription: "Run created event trigger",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"action_identifier": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the action whose runs trigger the automation",
+							Required:            true,
+						},
+					},
+				},
+				"run_updated_event": schema.SingleNestedAttribute{
+					MarkdownDescription: "Run updated event trigger",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"action_identifier": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the action whose runs trigger the automation",
+							Required:            true,
+						},
+					},
+				},
+				"run_status_changed_event": schema.SingleNestedAttribute{
+					MarkdownDescription: "Run status changed event trigger",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"action_identifier": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the action whose runs trigger the automation",
+							Required:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Trigger the automation only when the run changes to this status, `IN_PROGRESS`, `SUCCESS` or `FAILURE`",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(consts.RunInProgress, consts.RunSuccess, consts.RunFailure),
+							},
+						},
+					},
+				},
+				"cron_event": schema.SingleNestedAttribute{
+					MarkdownDescription: "Scheduled trigger",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"cron": schema.StringAttribute{
+							MarkdownDescription: "The schedule of the automation as a cron expression in UTC, e.g. `0 9 * * 1-5`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(cronRegex, "must be a cron expression with 5 fields, e.g. 0 9 * * 1-5"),
+							},
+						},
+					},
+				},
+				"rules_condition": schema.SingleNestedAttribute{
+					MarkdownDescription: "Rules condition for automation trigger, using Port's [search & query syntax](https://docs.getport.io/search-and-query/#rules). This can't be set at the same time as `jq_condition`",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"combinator": schema.StringAttribute{
+							MarkdownDescription: "The combinator of the rules",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("and"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("and", "or"),
+							},
+						},
+						"rules": schema.ListNestedAttribute{
+							MarkdownDescription: "The rules of the condition. Only one of `value`, `number_value`, `bool_value` and `values` can be set in a rule",
+							Required:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"property": schema.StringAttribute{
+										MarkdownDescription: "The property the rule applies to",
+										Required:            true,
+									},
+									"operator": schema.StringAttribute{
+										MarkdownDescription: "The operator of the rule",
+										Required:            true,
+									},
+									"value": schema.StringAttribute{
+										MarkdownDescription: "The value to compare to",
+										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.ConflictsWith(
+												path.MatchRelative().AtParent().AtName("number_value"),
+												path.MatchRelative().AtParent().AtName("bool_value"),
+												path.MatchRelative().AtParent().AtName("values"),
+											),
+										},
+									},
+									"number_value": schema.Float64Attribute{
+										MarkdownDescription: "The number value to compare to",
+										Optional:            true,
+										Validators: []validator.Float64{
+											float64validator.ConflictsWith(
+												path.MatchRelative().AtParent().AtName("bool_value"),
+												path.MatchRelative().AtParent().AtName("values"),
+											),
+										},
+									},
+									"bool_value": schema.BoolAttribute{
+										MarkdownDescription: "The boolean value to compare to",
+										Optional:            true,
+										Validators: []validator.Bool{
+											boolvalidator.ConflictsWith(
+												path.MatchRelative().AtParent().AtName("values"),
+											),
+										},
+									},
+									"values": schema.ListAttribute{
+										MarkdownDescription: "The values to compare to, for the `in` and `notIn` operators",
+										Optional:            true,
+										ElementType:         types.StringType,
+									},
+								},
+							},
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+					Validators: []validator.Object{
+						objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("jq_condition")),
+					},
+				},
 				"jq_condition": schema.SingleNestedAttribute{
 					MarkdownDescription: "JQ condition for automation trigger",
 					Optional:            true,
@@ -247,6 +416,7 @@ func ActionSchema() map[string]schema.Attribute {
 					path.MatchRoot("gitlab_method"),
 					path.MatchRoot("azure_method"),
 					path.MatchRoot("upsert_entity_method"),
+					path.MatchRoot("integration_method"),
 				),
 			},
 		},
@@ -273,14 +443,38 @@ func ActionSchema() map[string]schema.Attribute {
 					Optional:            true,
 				},
 				"headers": schema.MapAttribute{
-					MarkdownDescription: "The HTTP headers for invoking the action. They should be encoded as a key-value object to a string using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode). Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
+					MarkdownDescription: "The HTTP headers for invoking the action. They should be encoded as a key-value object to a string using [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode). Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload). Credentials should reference organization secrets, e.g. `Bearer {{ .secrets.TOKEN }}`, see `port_secret`.",
 					ElementType:         types.StringType,
 					Optional:            true,
+					Sensitive:           true,
 				},
 				"body": schema.StringAttribute{
 					MarkdownDescription: "The Webhook body should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
 				},
+				"tls": schema.SingleNestedAttribute{
+					MarkdownDescription: "The TLS settings for invoking the webhook",
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"verify": schema.BoolAttribute{
+							MarkdownDescription: "Whether to verify the TLS certificate of the webhook",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"ca_certificate": schema.StringAttribute{
+							MarkdownDescription: "The PEM encoded CA certificate to verify the TLS certificate of the webhook with",
+							Optional:            true,
+						},
+					},
+				},
+				"timeout": schema.Int64Attribute{
+					MarkdownDescription: "The number of seconds to wait for the webhook to respond",
+					Optional:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
 			},
 		},
 		"github_method": schema.SingleNestedAttribute{
@@ -308,6 +502,20 @@ func ActionSchema() map[string]schema.Attribute {
 					Optional:            true,
 					Validators:          StringBooleanOrJQTemplateValidator(),
 				},
+				"installation_id": schema.StringAttribute{
+					MarkdownDescription: "The installation ID of the GitHub app to invoke the workflow with, required when Port's GitHub app is installed more than once",
+					Optional:            true,
+				},
+				"omit_user_inputs": schema.StringAttribute{
+					MarkdownDescription: "Omit the user inputs from the workflow inputs",
+					Optional:            true,
+					Validators:          StringBooleanOrJQTemplateValidator(),
+				},
+				"omit_payload": schema.StringAttribute{
+					MarkdownDescription: "Omit the `port_payload` from the workflow inputs",
+					Optional:            true,
+					Validators:          StringBooleanOrJQTemplateValidator(),
+				},
 			},
 		},
 		"gitlab_method": schema.SingleNestedAttribute{
@@ -330,6 +538,28 @@ func ActionSchema() map[string]schema.Attribute {
 					MarkdownDescription: "The Gitlab pipeline variables should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
 					Optional:            true,
 				},
+				"agent": schema.StringAttribute{
+					MarkdownDescription: "Use the agent to trigger the pipeline instead of Port's GitLab app",
+					Optional:            true,
+					Validators:          StringBooleanOrJQTemplateValidator(),
+				},
+				"installation_id": schema.StringAttribute{
+					MarkdownDescription: "The installation ID of Port's GitLab app to trigger the pipeline with. This can't be set at the same time as `agent`",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("agent")),
+					},
+				},
+				"omit_user_inputs": schema.StringAttribute{
+					MarkdownDescription: "Omit the user inputs from the pipeline variables",
+					Optional:            true,
+					Validators:          StringBooleanOrJQTemplateValidator(),
+				},
+				"omit_payload": schema.StringAttribute{
+					MarkdownDescription: "Omit the `port_payload` from the pipeline variables",
+					Optional:            true,
+					Validators:          StringBooleanOrJQTemplateValidator(),
+				},
 			},
 		},
 		"azure_method": schema.SingleNestedAttribute{
@@ -391,10 +621,46 @@ func ActionSchema() map[string]schema.Attribute {
 				},
 			},
 		},
+		"integration_method": schema.SingleNestedAttribute{
+			MarkdownDescription: "Integration action invocation method, invoking an action of an Ocean integration",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"installation_id": schema.StringAttribute{
+					MarkdownDescription: "The installation ID of the integration",
+					Required:            true,
+				},
+				"integration_action_type": schema.StringAttribute{
+					MarkdownDescription: "The type of the integration action, e.g. `dispatch_workflow`",
+					Required:            true,
+				},
+				"execution_properties": schema.StringAttribute{
+					MarkdownDescription: "The execution properties of the integration action should be in `JSON` format, encoded as a string. Use [jsonencode](https://developer.hashicorp.com/terraform/language/functions/jsonencode) to encode arrays or objects. Learn about how to [define the action payload](https://docs.getport.io/create-self-service-experiences/setup-backend/#define-the-actions-payload).",
+					Optional:            true,
+				},
+			},
+		},
 		"required_approval": schema.BoolAttribute{
 			MarkdownDescription: "Require approval before invoking the action",
 			Optional:            true,
 		},
+		"required_approval_jq_query": schema.StringAttribute{
+			MarkdownDescription: "The jq query that determines whether a run requires approval, evaluated against the run. This can't be set at the same time as `required_approval` or `required_approval_min_approvers`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(
+					path.MatchRoot("required_approval"),
+					path.MatchRoot("required_approval_min_approvers"),
+				),
+			},
+		},
+		"required_approval_min_approvers": schema.Int64Attribute{
+			MarkdownDescription: "Require approval by at least this number of approvers before invoking the action. This can't be set at the same time as `required_approval`",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+				int64validator.ConflictsWith(path.MatchRoot("required_approval")),
+			},
+		},
 		"approval_webhook_notification": schema.SingleNestedAttribute{
 			MarkdownDescription: "The webhook notification of the approval",
 			Optional:            true,
@@ -416,10 +682,62 @@ func ActionSchema() map[string]schema.Attribute {
 			MarkdownDescription: "The email notification of the approval",
 			Optional:            true,
 			AttributeTypes:      map[string]attr.Type{},
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRoot("approval_webhook_notification"),
+					path.MatchRoot("approval_slack_notification"),
+					path.MatchRoot("approval_teams_notification"),
+				),
+			},
+		},
+		"approval_slack_notification": schema.SingleNestedAttribute{
+			MarkdownDescription: "The Slack notification of the approval, sent through Port's Slack app",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"channel": schema.StringAttribute{
+					MarkdownDescription: "The Slack channel to notify, e.g. `#approvals`",
+					Required:            true,
+				},
+			},
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRoot("approval_webhook_notification"),
+					path.MatchRoot("approval_teams_notification"),
+				),
+			},
+		},
+		"approval_teams_notification": schema.SingleNestedAttribute{
+			MarkdownDescription: "The Microsoft Teams notification of the approval",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					MarkdownDescription: "The URL of the Microsoft Teams incoming webhook",
+					Required:            true,
+				},
+			},
 			Validators: []validator.Object{
 				objectvalidator.ConflictsWith(path.MatchRoot("approval_webhook_notification")),
 			},
 		},
+		"approval_timeout": schema.SingleNestedAttribute{
+			MarkdownDescription: "The time to wait for the approval of a run",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"minutes": schema.Int64Attribute{
+					MarkdownDescription: "The number of minutes to wait for the approval",
+					Required:            true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"auto_reject": schema.BoolAttribute{
+					MarkdownDescription: "Whether to reject the run when the approval times out, by default the run stays pending approval",
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+				},
+			},
+		},
 		"publish": schema.BoolAttribute{
 			MarkdownDescription: "Publish action",
 			Optional:            true,
@@ -429,6 +747,110 @@ func ActionSchema() map[string]schema.Attribute {
 	}
 }
 
+// datasetRuleSchema returns the attributes of a dataset rule. Rules of the dataset itself can also be a group of
+// rules, with its own combinator, while the rules of a group can't be nested further.
+func datasetRuleSchema(allowGroup bool) map[string]schema.Attribute {
+	rule := map[string]schema.Attribute{
+		"blueprint": schema.StringAttribute{
+			MarkdownDescription: "The blueprint identifier of the rule, for rules on the relations of the entity",
+			Optional:            true,
+		},
+		"property": schema.StringAttribute{
+			MarkdownDescription: "The property identifier of the rule",
+			Optional:            true,
+		},
+		"operator": schema.StringAttribute{
+			MarkdownDescription: "The operator of the rule",
+			Required:            true,
+		},
+		"direction": schema.StringAttribute{
+			MarkdownDescription: "The direction of the relation of the rule, for `relatedTo` rules",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("upstream", "downstream"),
+				stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("blueprint")),
+			},
+		},
+		"value": schema.SingleNestedAttribute{
+			MarkdownDescription: "The value of the rule",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"jq_query": schema.StringAttribute{
+					MarkdownDescription: "The jq query of the value of the rule",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("literal"),
+							path.MatchRelative().AtParent().AtName("number_literal"),
+							path.MatchRelative().AtParent().AtName("bool_literal"),
+							path.MatchRelative().AtParent().AtName("literal_list"),
+							path.MatchRelative().AtParent().AtName("literal_json"),
+						),
+					},
+				},
+				"literal": schema.StringAttribute{
+					MarkdownDescription: "The literal string value of the rule",
+					Optional:            true,
+				},
+				"number_literal": schema.Float64Attribute{
+					MarkdownDescription: "The literal number value of the rule",
+					Optional:            true,
+				},
+				"bool_literal": schema.BoolAttribute{
+					MarkdownDescription: "The literal boolean value of the rule",
+					Optional:            true,
+				},
+				"literal_list": schema.ListAttribute{
+					MarkdownDescription: "The literal list of strings value of the rule, for operators comparing with multiple values such as `in` and `containsAny`",
+					Optional:            true,
+					ElementType:         types.StringType,
+				},
+				"literal_json": schema.StringAttribute{
+					MarkdownDescription: "The literal value of the rule as JSON, for values the other literals can't hold, such as lists of numbers",
+					Optional:            true,
+				},
+			},
+		},
+	}
+
+	if !allowGroup {
+		return rule
+	}
+
+	rule["operator"] = schema.StringAttribute{
+		MarkdownDescription: "The operator of the rule, required unless the rule is a group of rules",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("combinator")),
+		},
+	}
+	rule["combinator"] = schema.StringAttribute{
+		MarkdownDescription: "The combinator of the rules of the group, when the rule is a group of rules",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("and", "or"),
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("rules")),
+			stringvalidator.ConflictsWith(
+				path.MatchRelative().AtParent().AtName("blueprint"),
+				path.MatchRelative().AtParent().AtName("property"),
+				path.MatchRelative().AtParent().AtName("direction"),
+				path.MatchRelative().AtParent().AtName("value"),
+			),
+		},
+	}
+	rule["rules"] = schema.ListNestedAttribute{
+		MarkdownDescription: "The rules of the group, when the rule is a group of rules. Groups are nested one level deep, the rules of a group can't be groups themselves",
+		Optional:            true,
+		Validators: []validator.List{
+			listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("combinator")),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: datasetRuleSchema(false),
+		},
+	}
+	return rule
+}
+
 func StringPropertySchema() schema.Attribute {
 	stringPropertySchema := map[string]schema.Attribute{
 		"default": schema.StringAttribute{
@@ -517,27 +939,7 @@ func StringPropertySchema() schema.Attribute {
 					MarkdownDescription: "The rules of the dataset",
 					Required:            true,
 					NestedObject: schema.NestedAttributeObject{
-						Attributes: map[string]schema.Attribute{
-							"blueprint": schema.StringAttribute{
-								MarkdownDescription: "The blueprint identifier of the rule",
-								Optional:            true,
-							},
-							"property": schema.StringAttribute{
-								MarkdownDescription: "The property identifier of the rule",
-								Optional:            true,
-							},
-							"operator": schema.StringAttribute{
-								MarkdownDescription: "The operator of the rule",
-								Required:            true,
-							},
-							"value": schema.ObjectAttribute{
-								MarkdownDescription: "The value of the rule",
-								Required:            true,
-								AttributeTypes: map[string]attr.Type{
-									"jq_query": types.StringType,
-								},
-							},
-						},
+						Attributes: datasetRuleSchema(true),
 					},
 				},
 			},
@@ -846,6 +1248,119 @@ func (r *ActionResource) ValidateConfig(ctx context.Context, req resource.Valida
 	}
 
 	validateUserInputRequiredNotSetToFalse(ctx, state, resp)
+	validateApprovalTimeout(state, resp)
+	validateSteps(ctx, req, resp)
+	validateJqTemplates(ctx, req, resp)
+}
+
+// declaredUserProperties returns the identifiers of the user properties of the self service trigger, declared either
+// in user_properties or in user_inputs_json. It returns false when they are not known yet.
+func declaredUserProperties(ctx context.Context, config tfsdk.Config) (map[string]bool, bool) {
+	triggerPath := path.Root("self_service_trigger")
+	declared := make(map[string]bool)
+
+	for _, props := range []string{"string_props", "number_props", "boolean_props", "object_props", "array_props"} {
+		var m types.Map
+		if diags := config.GetAttribute(ctx, triggerPath.AtName("user_properties").AtName(props), &m); diags.HasError() || m.IsUnknown() {
+			return nil, false
+		}
+		for identifier := range m.Elements() {
+			declared[identifier] = true
+		}
+	}
+
+	var userInputsJSON types.String
+	if diags := config.GetAttribute(ctx, triggerPath.AtName("user_inputs_json"), &userInputsJSON); diags.HasError() || userInputsJSON.IsUnknown() {
+		return nil, false
+	}
+	if !userInputsJSON.IsNull() {
+		userInputs, err := utils.TerraformStringToGoType[cli.ActionUserInputs](userInputsJSON)
+		if err != nil {
+			return nil, false
+		}
+		for identifier := range userInputs.Properties {
+			declared[identifier] = true
+		}
+	}
+
+	return declared, true
+}
+
+// validateSteps checks that the steps of the self service trigger list declared user properties, that every property
+// is in a single step, and that order_properties lists the properties in the order of the steps
+func validateSteps(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	triggerPath := path.Root("self_service_trigger")
+
+	var steps []StepModel
+	if diags := req.Config.GetAttribute(ctx, triggerPath.AtName("steps"), &steps); diags.HasError() || steps == nil {
+		return
+	}
+
+	declared, ok := declaredUserProperties(ctx, req.Config)
+	if !ok {
+		return
+	}
+
+	var stepsOrder []string
+	stepOf := make(map[string]int)
+	for i, step := range steps {
+		if step.Order.IsUnknown() {
+			return
+		}
+		orderPath := triggerPath.AtName("steps").AtListIndex(i).AtName("order")
+		for j, element := range step.Order.Elements() {
+			identifier, ok := element.(types.String)
+			if !ok || identifier.IsUnknown() {
+				return
+			}
+			p := orderPath.AtListIndex(j)
+			if !declared[identifier.ValueString()] {
+				resp.Diagnostics.AddAttributeError(p, "unknown user property", fmt.Sprintf("step %q lists %s, which is not declared in user_properties", step.Title.ValueString(), identifier.ValueString()))
+				continue
+			}
+			if other, ok := stepOf[identifier.ValueString()]; ok {
+				resp.Diagnostics.AddAttributeError(p, "duplicate user property", fmt.Sprintf("%s is listed in both step %d and step %d", identifier.ValueString(), other+1, i+1))
+				continue
+			}
+			stepOf[identifier.ValueString()] = i
+			stepsOrder = append(stepsOrder, identifier.ValueString())
+		}
+	}
+
+	var orderProperties types.List
+	if diags := req.Config.GetAttribute(ctx, triggerPath.AtName("order_properties"), &orderProperties); diags.HasError() || orderProperties.IsNull() || orderProperties.IsUnknown() {
+		return
+	}
+	var order []string
+	for _, element := range orderProperties.Elements() {
+		identifier, ok := element.(types.String)
+		if !ok || identifier.IsUnknown() {
+			return
+		}
+		order = append(order, identifier.ValueString())
+	}
+	if strings.Join(order, ",") != strings.Join(stepsOrder, ",") {
+		resp.Diagnostics.AddAttributeError(triggerPath.AtName("order_properties"), "inconsistent order_properties",
+			fmt.Sprintf("order_properties must list the properties of the steps in the order of the steps: %v", stepsOrder))
+	}
+}
+
+// validateApprovalTimeout checks that approval_timeout is only set on actions that require approval
+func validateApprovalTimeout(state *ActionValidationModel, resp *resource.ValidateConfigResponse) {
+	if state.ApprovalTimeout.IsNull() {
+		return
+	}
+	if state.RequiredApproval.IsUnknown() || state.RequiredApprovalJqQuery.IsUnknown() || state.RequiredApprovalMinApprovers.IsUnknown() {
+		return
+	}
+	if state.RequiredApproval.ValueBool() || !state.RequiredApprovalJqQuery.IsNull() || !state.RequiredApprovalMinApprovers.IsNull() {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		path.Root("approval_timeout"),
+		"approval timeout without required approval",
+		"approval_timeout can only be set when the action requires approval, set required_approval, required_approval_jq_query or required_approval_min_approvers",
+	)
 }
 
 func validateUserInputRequiredNotSetToFalse(ctx context.Context, state *ActionValidationModel, resp *resource.ValidateConfigResponse) {
@@ -1130,6 +1645,93 @@ resource "port_action" "delete_temporary_microservice" {
 }
 ` + "\n```" + `
 
+## Example Usage With Scheduled and Run Triggered Automations
+
+Automations can also run on a cron schedule, or when a run of another action is created, updated or changes status. The ` + "`rules_condition`" + ` filters the triggering events with Port's search rules.
+
+` + "```hcl" + `
+resource "port_action" "nightly_cleanup" {
+	title = "Nightly Cleanup"
+	identifier = "nightly-cleanup"
+	icon = "Terraform"
+	automation_trigger = {
+		cron_event = {
+			cron = "0 2 * * *"
+		}
+	}
+	kafka_method = {
+		payload = jsonencode({
+		  runId: "{{"{{.run.id}}"}}"
+		})
+	}
+}
+
+resource "port_action" "notify_failed_deployment" {
+	title = "Notify Failed Deployment"
+	identifier = "notify-failed-deployment"
+	icon = "Terraform"
+	automation_trigger = {
+		run_status_changed_event = {
+			action_identifier = port_action.deploy.identifier
+			status = "FAILURE"
+		}
+	}
+	webhook_method = {
+		url = "https://example.com/notify"
+	}
+}
+
+resource "port_action" "production_change" {
+	title = "Production Change"
+	identifier = "production-change"
+	icon = "Terraform"
+	automation_trigger = {
+		entity_updated_event = {
+			blueprint_identifier = port_blueprint.microservice.identifier
+		}
+		rules_condition = {
+			rules = [
+				{
+					property = "environment"
+					operator = "="
+					value = "production"
+				}
+			]
+		}
+	}
+	kafka_method = {
+		payload = jsonencode({
+		  runId: "{{"{{.run.id}}"}}"
+		})
+	}
+}
+` + "\n```" + `
+
+## Example Usage With Approval
+
+Runs can require approval by a minimum number of approvers or when a jq query evaluated against the run is true, and are rejected automatically when no one approves them in time.
+
+` + "```hcl" + `
+resource "port_action" "delete_microservice" {
+	title = "Delete Microservice"
+	identifier = "delete-microservice"
+	icon = "Terraform"
+	self_service_trigger = {
+		operation = "DELETE"
+		blueprint_identifier = port_blueprint.microservice.identifier
+	}
+	kafka_method = {}
+	required_approval_min_approvers = 2
+	approval_slack_notification = {
+		channel = "#approvals"
+	}
+	approval_timeout = {
+		minutes = 120
+		auto_reject = true
+	}
+}
+` + "\n```" + `
+
 ## Example Usage With Condition
 
 ` + "```hcl" + `
@@ -1168,4 +1770,31 @@ resource "port_action" "create_microservice" {
 	
 ` + "```" + `
 
+## Example Usage With User Inputs JSON
+
+Actions exported from Port can be pasted as is by setting ` + "`user_inputs_json`" + ` instead of ` + "`user_properties`" + `.
+
+` + "```hcl" + `
+resource "port_action" "create_microservice" {
+	title = "Create Microservice"
+	identifier = "create-microservice"
+	icon = "Terraform"
+	self_service_trigger = {
+		operation = "CREATE"
+		blueprint_identifier = port_blueprint.microservice.identifier
+		user_inputs_json = jsonencode({
+			properties = {
+				name = {
+					type = "string"
+					title = "Name"
+				}
+			}
+			required = ["name"]
+			order = ["name"]
+		})
+	}
+	kafka_method = {}
+}
+` + "```" + `
+
 `
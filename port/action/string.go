@@ -100,7 +100,11 @@ func stringPropResourceToBody(ctx context.Context, d *SelfServiceTriggerModel, p
 		}
 
 		if prop.Dataset != nil {
-			property.Dataset = actionDataSetToPortBody(prop.Dataset)
+			dataset, err := actionDataSetToPortBody(ctx, prop.Dataset)
+			if err != nil {
+				return err
+			}
+			property.Dataset = dataset
 		}
 
 		if !prop.Visible.IsNull() {
@@ -131,7 +135,7 @@ func addStringPropertiesToResource(ctx context.Context, v *cli.ActionProperty) *
 		Format:     flex.GoStringToFramework(v.Format),
 		Blueprint:  flex.GoStringToFramework(v.Blueprint),
 		Encryption: flex.GoStringToFramework(v.Encryption),
-		Dataset:    writeDatasetToResource(v.Dataset),
+		Dataset:    writeDatasetToResource(ctx, v.Dataset),
 	}
 
 	if v.Enum != nil {
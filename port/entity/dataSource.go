@@ -0,0 +1,102 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ datasource.DataSource = &EntityDataSource{}
+
+func NewEntityDataSource() datasource.DataSource {
+	return &EntityDataSource{}
+}
+
+type EntityDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *EntityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *EntityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity"
+}
+
+func (d *EntityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EntityDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	e, _, err := d.portClient.ReadEntity(ctx, data.Identifier.ValueString(), data.Blueprint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read entity", err.Error())
+		return
+	}
+
+	b, _, err := d.portClient.ReadCachedBlueprint(ctx, data.Blueprint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+
+	propertiesJSON, err := entityPropertiesToJSON(e)
+	if err != nil {
+		resp.Diagnostics.AddError("failed writing entity fields to data source", err.Error())
+		return
+	}
+
+	// the entity is read into the model of the resource, so the data source and the resource expose the fields the same way
+	entity := &EntityModel{}
+	err = refreshEntityState(ctx, entity, e, b)
+	if err != nil {
+		resp.Diagnostics.AddError("failed writing entity fields to data source", err.Error())
+		return
+	}
+
+	data = EntityDataModel{
+		ID:             types.StringValue(fmt.Sprintf("%s:%s", e.Blueprint, e.Identifier)),
+		Identifier:     entity.Identifier,
+		Blueprint:      entity.Blueprint,
+		Title:          entity.Title,
+		Icon:           entity.Icon,
+		RunID:          entity.RunID,
+		CreatedAt:      entity.CreatedAt,
+		CreatedBy:      entity.CreatedBy,
+		UpdatedAt:      entity.UpdatedAt,
+		UpdatedBy:      entity.UpdatedBy,
+		Properties:     entity.Properties,
+		PropertiesJSON: propertiesJSON,
+		Teams:          entity.Teams,
+		Scorecards:     entity.Scorecards,
+		Relations:      entity.Relations,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// entityPropertiesToJSON returns all the properties of the entity as a JSON object, including properties that have no
+// typed attribute
+func entityPropertiesToJSON(e *cli.Entity) (types.String, error) {
+	properties := e.Properties
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	propertiesJSON, err := json.Marshal(properties)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(propertiesJSON)), nil
+}
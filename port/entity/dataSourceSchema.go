@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func EntityDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the entity",
+			Required:            true,
+		},
+		"blueprint": schema.StringAttribute{
+			MarkdownDescription: "The blueprint identifier the entity relates to",
+			Required:            true,
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the entity",
+			Computed:            true,
+		},
+		"icon": schema.StringAttribute{
+			MarkdownDescription: "The icon of the entity",
+			Computed:            true,
+		},
+		"run_id": schema.StringAttribute{
+			MarkdownDescription: "The runID of the action run that created the entity",
+			Computed:            true,
+		},
+		"teams": schema.ListAttribute{
+			MarkdownDescription: "The teams the entity belongs to",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"properties": schema.SingleNestedAttribute{
+			MarkdownDescription: "The properties of the entity",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"string_props": schema.MapAttribute{
+					MarkdownDescription: "The string properties of the entity",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"number_props": schema.MapAttribute{
+					MarkdownDescription: "The number properties of the entity",
+					Computed:            true,
+					ElementType:         types.Float64Type,
+				},
+				"boolean_props": schema.MapAttribute{
+					MarkdownDescription: "The bool properties of the entity",
+					Computed:            true,
+					ElementType:         types.BoolType,
+				},
+				"object_props": schema.MapAttribute{
+					MarkdownDescription: "The object properties of the entity",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"array_props": schema.SingleNestedAttribute{
+					MarkdownDescription: "The array properties of the entity",
+					Computed:            true,
+					Attributes: map[string]schema.Attribute{
+						"string_items": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.StringType},
+							Computed:    true,
+						},
+						"number_items": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.Float64Type},
+							Computed:    true,
+						},
+						"boolean_items": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.BoolType},
+							Computed:    true,
+						},
+						"object_items": schema.MapAttribute{
+							ElementType: types.ListType{ElemType: types.StringType},
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		"properties_json": schema.StringAttribute{
+			MarkdownDescription: "The properties of the entity as a JSON object keyed by the property identifier, use `jsondecode` to read properties of any type",
+			Computed:            true,
+		},
+		"relations": schema.SingleNestedAttribute{
+			MarkdownDescription: "The relations of the entity",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"single_relations": schema.MapAttribute{
+					MarkdownDescription: "The single relation of the entity",
+					Computed:            true,
+					ElementType:         types.StringType,
+				},
+				"many_relations": schema.MapAttribute{
+					MarkdownDescription: "The many relation of the entity",
+					Computed:            true,
+					ElementType:         types.ListType{ElemType: types.StringType},
+				},
+			},
+		},
+		"scorecards": schema.MapAttribute{
+			MarkdownDescription: "The scorecards of the entity",
+			Computed:            true,
+			ElementType:         scorecardType,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The creation date of the entity",
+			Computed:            true,
+		},
+		"created_by": schema.StringAttribute{
+			MarkdownDescription: "The creator of the entity",
+			Computed:            true,
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The last update date of the entity",
+			Computed:            true,
+		},
+		"updated_by": schema.StringAttribute{
+			MarkdownDescription: "The last updater of the entity",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *EntityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: EntityDataSourceMarkdownDescription,
+		Attributes:          EntityDataSourceSchema(),
+	}
+}
+
+var EntityDataSourceMarkdownDescription = `
+
+# Entity Data Source
+
+The entity data source allows you to read a single entity in Port, including its scorecards.
+
+## Example Usage
+
+### Fail the plan when a service hasn't reached the Gold level of the Production Readiness scorecard:
+
+` + "```hcl" + `
+
+data "port_entity" "checkout" {
+  identifier = "checkout"
+  blueprint  = "microservice"
+}
+
+resource "terraform_data" "production_readiness" {
+  lifecycle {
+    precondition {
+      condition     = data.port_entity.checkout.scorecards["production_readiness"].level == "Gold"
+      error_message = "The checkout service must reach the Gold level of the Production Readiness scorecard"
+    }
+  }
+}
+
+` + "```" + `
+`
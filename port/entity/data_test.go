@@ -0,0 +1,82 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func TestAccPortEntityDataSource(t *testing.T) {
+	identifier := utils.GenID()
+	scorecardIdentifier := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  {
+					"title" = "My String Identifier"
+				}
+			}
+		}
+	}
+	resource "port_scorecard" "microservice" {
+		identifier = "%s"
+		title      = "Scorecard 1"
+		blueprint  = port_blueprint.microservice.identifier
+		rules = [{
+			identifier = "hasString"
+			title      = "Has String"
+			level      = "Gold"
+			query = {
+				combinator = "and"
+				conditions = [jsonencode({
+					property = "myStringIdentifier"
+					operator = "isNotEmpty"
+				})]
+			}
+		}]
+	}
+	resource "port_entity" "microservice" {
+		depends_on = [port_scorecard.microservice]
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  "My String Value"
+			}
+		}
+	}`, identifier, scorecardIdentifier)
+
+	var testEntityDataSource = `
+	data "port_entity" "microservice" {
+		identifier = port_entity.microservice.identifier
+		blueprint = port_entity.microservice.blueprint
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate + testEntityDataSource,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_entity.microservice", "title", "TF Provider Test Entity0"),
+					resource.TestCheckResourceAttr("data.port_entity.microservice", "properties.string_props.myStringIdentifier", "My String Value"),
+					resource.TestCheckResourceAttr("data.port_entity.microservice", fmt.Sprintf("scorecards.%s.level", scorecardIdentifier), "Gold"),
+					resource.TestCheckResourceAttr("data.port_entity.microservice", fmt.Sprintf("scorecards.%s.rules.0.status", scorecardIdentifier), "SUCCESS"),
+					resource.TestCheckResourceAttr("port_entity.microservice", fmt.Sprintf("scorecards.%s.level", scorecardIdentifier), "Gold"),
+				),
+			},
+		},
+	})
+}
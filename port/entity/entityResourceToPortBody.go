@@ -145,6 +145,8 @@ func entityResourceToBody(ctx context.Context, state *EntityModel, bp *cli.Bluep
 		}
 	}
 
+	writeTimerPropsToBody(state, properties)
+
 	e.Properties = properties
 
 	relations, err := writeRelationsToBody(ctx, state.Relations)
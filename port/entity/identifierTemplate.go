@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+var identifierRegex = regexp.MustCompile(`^[A-Za-z0-9@_.+:\\/=-]+$`)
+
+var invalidIdentifierCharactersRegex = regexp.MustCompile(`[^A-Za-z0-9@_.+:\\/=-]+`)
+
+var identifierTemplateFuncs = template.FuncMap{
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+	"trim":  strings.TrimSpace,
+	// slug replaces every run of characters that are not allowed in an identifier with a single dash
+	"slug": func(s string) string {
+		return strings.Trim(invalidIdentifierCharactersRegex.ReplaceAllString(strings.ToLower(s), "-"), "-")
+	},
+}
+
+// renderIdentifierTemplate renders the identifier template with the title, blueprint and scalar properties of the
+// entity. The returned bool is false when one of the values the template may use is unknown at plan time.
+func renderIdentifierTemplate(identifierTemplate string, state *EntityModel) (string, bool, error) {
+	t, err := template.New("identifier").Funcs(identifierTemplateFuncs).Option("missingkey=error").Parse(identifierTemplate)
+	if err != nil {
+		return "", false, err
+	}
+
+	if state.Title.IsUnknown() || state.Blueprint.IsUnknown() {
+		return "", false, nil
+	}
+
+	properties := make(map[string]any)
+	if state.Properties != nil {
+		for identifier, prop := range state.Properties.StringProps {
+			if prop.IsUnknown() {
+				return "", false, nil
+			}
+			properties[identifier] = prop.ValueString()
+		}
+		for identifier, prop := range state.Properties.NumberProps {
+			if prop.IsUnknown() {
+				return "", false, nil
+			}
+			properties[identifier] = prop.ValueFloat64()
+		}
+		for identifier, prop := range state.Properties.BooleanProps {
+			if prop.IsUnknown() {
+				return "", false, nil
+			}
+			properties[identifier] = prop.ValueBool()
+		}
+	}
+
+	var identifier bytes.Buffer
+	err = t.Execute(&identifier, map[string]any{
+		"title":      state.Title.ValueString(),
+		"blueprint":  state.Blueprint.ValueString(),
+		"properties": properties,
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	if !identifierRegex.MatchString(identifier.String()) {
+		return "", false, fmt.Errorf("the rendered identifier %q must match the pattern %s, use the slug function to remove invalid characters", identifier.String(), identifierRegex.String())
+	}
+
+	return identifier.String(), true, nil
+}
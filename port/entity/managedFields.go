@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+func declaredPropertiesIdentifiers(properties *EntityPropertiesModel) map[string]bool {
+	declared := make(map[string]bool)
+	if properties == nil {
+		return declared
+	}
+
+	for identifier := range properties.StringProps {
+		declared[identifier] = true
+	}
+	for identifier := range properties.NumberProps {
+		declared[identifier] = true
+	}
+	for identifier := range properties.BooleanProps {
+		declared[identifier] = true
+	}
+	for identifier := range properties.ObjectProps {
+		declared[identifier] = true
+	}
+
+	if properties.ArrayProps != nil {
+		for _, items := range []types.Map{
+			properties.ArrayProps.StringItems,
+			properties.ArrayProps.NumberItems,
+			properties.ArrayProps.BooleanItems,
+			properties.ArrayProps.ObjectItems,
+		} {
+			for identifier := range items.Elements() {
+				declared[identifier] = true
+			}
+		}
+	}
+
+	return declared
+}
+
+func declaredRelationsIdentifiers(relations *RelationModel) map[string]bool {
+	declared := make(map[string]bool)
+	if relations == nil {
+		return declared
+	}
+
+	for identifier := range relations.SingleRelation {
+		declared[identifier] = true
+	}
+	for identifier := range relations.ManyRelations {
+		declared[identifier] = true
+	}
+
+	return declared
+}
+
+// filterUnmanagedFields drops the properties and relations of the entity that are not declared in the state, so fields
+// owned by other writers (integrations, self-service actions) don't show up as drift.
+func filterUnmanagedFields(state *EntityModel, e *cli.Entity) {
+	declaredProperties := declaredPropertiesIdentifiers(state.Properties)
+	for identifier := range e.Properties {
+		if !declaredProperties[identifier] {
+			delete(e.Properties, identifier)
+		}
+	}
+
+	declaredRelations := declaredRelationsIdentifiers(state.Relations)
+	for identifier := range e.Relations {
+		if !declaredRelations[identifier] {
+			delete(e.Relations, identifier)
+		}
+	}
+}
+
+// unsetRemovedFields marks the properties and relations that were declared in the previous state but removed from the
+// plan with a nil value, and clears the teams when they were removed, so a partial update unsets them instead of leaving
+// them behind.
+func unsetRemovedFields(previousState *EntityModel, state *EntityModel, e *cli.Entity) {
+	declaredProperties := declaredPropertiesIdentifiers(state.Properties)
+	for identifier := range declaredPropertiesIdentifiers(previousState.Properties) {
+		if !declaredProperties[identifier] {
+			e.Properties[identifier] = nil
+		}
+	}
+
+	for identifier := range previousState.TimerProps {
+		if _, ok := state.TimerProps[identifier]; !ok {
+			e.Properties[identifier] = nil
+		}
+	}
+
+	declaredRelations := declaredRelationsIdentifiers(state.Relations)
+	for identifier := range declaredRelationsIdentifiers(previousState.Relations) {
+		if !declaredRelations[identifier] {
+			e.Relations[identifier] = nil
+		}
+	}
+
+	if previousState.Teams != nil && state.Teams == nil {
+		e.Team = []string{}
+	}
+}
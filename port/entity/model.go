@@ -24,18 +24,46 @@ type RelationModel struct {
 	ManyRelations  map[string][]string `tfsdk:"many_relations"`
 }
 
+type TimerPropModel struct {
+	ExpiresIn types.String `tfsdk:"expires_in"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
 type EntityModel struct {
-	ID         types.String           `tfsdk:"id"`
-	Identifier types.String           `tfsdk:"identifier"`
-	Blueprint  types.String           `tfsdk:"blueprint"`
-	Title      types.String           `tfsdk:"title"`
-	Icon       types.String           `tfsdk:"icon"`
-	RunID      types.String           `tfsdk:"run_id"`
-	CreatedAt  types.String           `tfsdk:"created_at"`
-	CreatedBy  types.String           `tfsdk:"created_by"`
-	UpdatedAt  types.String           `tfsdk:"updated_at"`
-	UpdatedBy  types.String           `tfsdk:"updated_by"`
-	Properties *EntityPropertiesModel `tfsdk:"properties"`
-	Teams      []types.String         `tfsdk:"teams"`
-	Relations  *RelationModel         `tfsdk:"relations"`
+	ID                      types.String              `tfsdk:"id"`
+	Identifier              types.String              `tfsdk:"identifier"`
+	IdentifierTemplate      types.String              `tfsdk:"identifier_template"`
+	Blueprint               types.String              `tfsdk:"blueprint"`
+	Title                   types.String              `tfsdk:"title"`
+	Icon                    types.String              `tfsdk:"icon"`
+	RunID                   types.String              `tfsdk:"run_id"`
+	ManagedFieldsOnly       types.Bool                `tfsdk:"managed_fields_only"`
+	CreatedAt               types.String              `tfsdk:"created_at"`
+	CreatedBy               types.String              `tfsdk:"created_by"`
+	UpdatedAt               types.String              `tfsdk:"updated_at"`
+	UpdatedBy               types.String              `tfsdk:"updated_by"`
+	Properties              *EntityPropertiesModel    `tfsdk:"properties"`
+	Teams                   []types.String            `tfsdk:"teams"`
+	Relations               *RelationModel            `tfsdk:"relations"`
+	TimerProps              map[string]TimerPropModel `tfsdk:"timer_props"`
+	TreatTimerExpiryAsDrift types.Bool                `tfsdk:"treat_timer_expiry_as_drift"`
+	Scorecards              types.Map                 `tfsdk:"scorecards"`
+}
+
+type EntityDataModel struct {
+	ID             types.String           `tfsdk:"id"`
+	Identifier     types.String           `tfsdk:"identifier"`
+	Blueprint      types.String           `tfsdk:"blueprint"`
+	Title          types.String           `tfsdk:"title"`
+	Icon           types.String           `tfsdk:"icon"`
+	RunID          types.String           `tfsdk:"run_id"`
+	CreatedAt      types.String           `tfsdk:"created_at"`
+	CreatedBy      types.String           `tfsdk:"created_by"`
+	UpdatedAt      types.String           `tfsdk:"updated_at"`
+	UpdatedBy      types.String           `tfsdk:"updated_by"`
+	Properties     *EntityPropertiesModel `tfsdk:"properties"`
+	PropertiesJSON types.String           `tfsdk:"properties_json"`
+	Teams          []types.String         `tfsdk:"teams"`
+	Scorecards     types.Map              `tfsdk:"scorecards"`
+	Relations      *RelationModel         `tfsdk:"relations"`
 }
@@ -3,6 +3,8 @@ package entity
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
@@ -167,6 +169,12 @@ func refreshRelationsEntityState(ctx context.Context, state *EntityModel, e *cli
 }
 
 func refreshEntityState(ctx context.Context, state *EntityModel, e *cli.Entity, blueprint *cli.Blueprint) error {
+	refreshTimerPropsState(state, e, time.Now())
+
+	if state.ManagedFieldsOnly.ValueBool() {
+		filterUnmanagedFields(state, e)
+	}
+
 	state.ID = types.StringValue(e.Identifier)
 	state.Identifier = types.StringValue(e.Identifier)
 	state.Blueprint = types.StringValue(blueprint.Identifier)
@@ -191,5 +199,19 @@ func refreshEntityState(ctx context.Context, state *EntityModel, e *cli.Entity,
 		refreshRelationsEntityState(ctx, state, e)
 	}
 
+	return refreshScorecardsEntityState(ctx, state, e)
+}
+
+func refreshScorecardsEntityState(ctx context.Context, state *EntityModel, e *cli.Entity) error {
+	if len(e.Scorecards) == 0 {
+		state.Scorecards = types.MapNull(scorecardType)
+		return nil
+	}
+
+	scorecards, diags := types.MapValueFrom(ctx, scorecardType, e.Scorecards)
+	if diags.HasError() {
+		return fmt.Errorf("failed to convert the scorecards of the entity: %v", diags.Errors())
+	}
+	state.Scorecards = scorecards
 	return nil
 }
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"context"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// migrateRelations points the relations of the entities that are related to the old identifier to the new one, so
+// renaming an entity (which is done by creating a new entity and deleting the old one) doesn't break its dependents.
+func migrateRelations(ctx context.Context, portClient *cli.PortClient, blueprint string, oldIdentifier string, newIdentifier string) error {
+	query := map[string]any{
+		"combinator": "and",
+		"rules": []any{
+			map[string]any{
+				"operator":  "relatedTo",
+				"blueprint": blueprint,
+				"value":     oldIdentifier,
+				"direction": "downstream",
+			},
+		},
+	}
+
+	searchResult, err := portClient.Search(ctx, &cli.SearchRequestQuery{Query: &query})
+	if err != nil {
+		return err
+	}
+
+	blueprints := make(map[string]*cli.Blueprint)
+	for _, related := range searchResult.Entities {
+		b, ok := blueprints[related.Blueprint]
+		if !ok {
+			b, _, err = portClient.ReadCachedBlueprint(ctx, related.Blueprint)
+			if err != nil {
+				return err
+			}
+			blueprints[related.Blueprint] = b
+		}
+
+		relations := make(map[string]any)
+		for identifier, relation := range b.Relations {
+			if relation.Target == nil || *relation.Target != blueprint {
+				continue
+			}
+
+			switch v := related.Relations[identifier].(type) {
+			case string:
+				if v == oldIdentifier {
+					relations[identifier] = newIdentifier
+				}
+			case []interface{}:
+				replaced := false
+				values := make([]interface{}, len(v))
+				for i, item := range v {
+					values[i] = item
+					if item == oldIdentifier {
+						values[i] = newIdentifier
+						replaced = true
+					}
+				}
+				if replaced {
+					relations[identifier] = values
+				}
+			}
+		}
+
+		if len(relations) == 0 {
+			continue
+		}
+
+		_, err = portClient.PatchEntity(ctx, related.Identifier, related.Blueprint, &cli.Entity{Relations: relations}, "")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
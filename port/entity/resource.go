@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,6 +15,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces
 var _ resource.Resource = &EntityResource{}
 var _ resource.ResourceWithImportState = &EntityResource{}
+var _ resource.ResourceWithModifyPlan = &EntityResource{}
 
 func NewEntityResource() resource.Resource {
 	return &EntityResource{}
@@ -35,6 +37,62 @@ func (r *EntityResource) Configure(ctx context.Context, req resource.ConfigureRe
 	r.portClient = req.ProviderData.(*cli.PortClient)
 }
 
+func (r *EntityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	modifyPlanIdentifierFromTemplate(ctx, req, resp)
+	modifyPlanTimerProps(ctx, req, resp)
+	if resp.Diagnostics.HasError() || r.portClient == nil {
+		return
+	}
+
+	// values that are unknown at plan time (e.g. references to other resources) can't be validated, and are checked by
+	// the API during the apply instead
+	state, unknown := plannedEntity(ctx, req.Plan)
+	if state.Blueprint.IsNull() || state.Blueprint.IsUnknown() {
+		return
+	}
+
+	b, statusCode, err := r.portClient.ReadCachedBlueprint(ctx, state.Blueprint.ValueString())
+	if err != nil {
+		// the blueprint may be created in the same apply
+		if statusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validateEntityAgainstBlueprint(state, b, unknown)...)
+	resp.Diagnostics.Append(validateRelationTargets(ctx, r.portClient, state, b)...)
+}
+
+func modifyPlanIdentifierFromTemplate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var identifierTemplate types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("identifier_template"), &identifierTemplate)...)
+	if resp.Diagnostics.HasError() || identifierTemplate.IsNull() {
+		return
+	}
+
+	identifier := types.StringUnknown()
+	var state *EntityModel
+	if !identifierTemplate.IsUnknown() && !req.Plan.Get(ctx, &state).HasError() {
+		renderedIdentifier, known, err := renderIdentifierTemplate(identifierTemplate.ValueString(), state)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("identifier_template"), "failed to render identifier template", err.Error())
+			return
+		}
+		if known {
+			identifier = types.StringValue(renderedIdentifier)
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("identifier"), identifier)...)
+}
+
 func (r *EntityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var state *EntityModel
 
@@ -54,7 +112,7 @@ func (r *EntityResource) Read(ctx context.Context, req resource.ReadRequest, res
 		resp.Diagnostics.AddError("failed to read entity", err.Error())
 		return
 	}
-	b, _, err := r.portClient.ReadBlueprint(ctx, blueprintIdentifier)
+	b, _, err := r.portClient.ReadCachedBlueprint(ctx, blueprintIdentifier)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
 		return
@@ -76,12 +134,18 @@ func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	bp, _, err := r.portClient.ReadBlueprint(ctx, state.Blueprint.ValueString())
+	bp, _, err := r.portClient.ReadCachedBlueprint(ctx, state.Blueprint.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
 		return
 	}
 
+	err = resolveTimerProps(state, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to compute timer properties", err.Error())
+		return
+	}
+
 	e, err := entityResourceToBody(ctx, state, bp)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert entity resource to body", err.Error())
@@ -93,24 +157,29 @@ func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest,
 		runID = state.RunID.ValueString()
 	}
 
-	en, err := r.portClient.CreateEntity(ctx, e, runID)
+	en, err := r.portClient.CreateEntity(ctx, e, runID, state.ManagedFieldsOnly.ValueBool())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create entity", err.Error())
 		return
 	}
 
-	writeEntityComputedFieldsToState(state, en)
+	err = writeEntityComputedFieldsToState(ctx, state, en)
+	if err != nil {
+		resp.Diagnostics.AddError("failed writing entity fields to resource", err.Error())
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func writeEntityComputedFieldsToState(state *EntityModel, e *cli.Entity) {
+func writeEntityComputedFieldsToState(ctx context.Context, state *EntityModel, e *cli.Entity) error {
 	state.ID = types.StringValue(fmt.Sprintf("%s:%s", e.Blueprint, e.Identifier))
 	state.Identifier = types.StringValue(e.Identifier)
 	state.CreatedAt = types.StringValue(e.CreatedAt.String())
 	state.CreatedBy = types.StringValue(e.CreatedBy)
 	state.UpdatedAt = types.StringValue(e.UpdatedAt.String())
 	state.UpdatedBy = types.StringValue(e.UpdatedBy)
+	return refreshScorecardsEntityState(ctx, state, e)
 }
 
 func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -124,12 +193,18 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	bp, _, err := r.portClient.ReadBlueprint(ctx, state.Blueprint.ValueString())
+	bp, _, err := r.portClient.ReadCachedBlueprint(ctx, state.Blueprint.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
 		return
 	}
 
+	err = resolveTimerProps(state, time.Now())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to compute timer properties", err.Error())
+		return
+	}
+
 	e, err := entityResourceToBody(ctx, state, bp)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert entity resource to body", err.Error())
@@ -144,9 +219,14 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 	var en *cli.Entity
 
 	isBlueprintChanged := !previousState.Blueprint.IsNull() && previousState.Blueprint.ValueString() != state.Blueprint.ValueString()
-
-	if previousState.Identifier.IsNull() || isBlueprintChanged {
-		en, err = r.portClient.CreateEntity(ctx, e, runID)
+	// Port doesn't support changing the identifier of an entity, so it is renamed by creating a new entity
+	isIdentifierChanged := !previousState.Identifier.IsNull() && !state.Identifier.IsUnknown() && previousState.Identifier.ValueString() != state.Identifier.ValueString()
+
+	if previousState.Identifier.IsNull() || isBlueprintChanged || isIdentifierChanged {
+		en, err = r.portClient.CreateEntity(ctx, e, runID, state.ManagedFieldsOnly.ValueBool())
+	} else if state.ManagedFieldsOnly.ValueBool() {
+		unsetRemovedFields(previousState, state, e)
+		en, err = r.portClient.PatchEntity(ctx, previousState.Identifier.ValueString(), previousState.Blueprint.ValueString(), e, runID)
 	} else {
 		en, err = r.portClient.UpdateEntity(ctx, previousState.Identifier.ValueString(), previousState.Blueprint.ValueString(), e, runID)
 	}
@@ -156,7 +236,15 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	if isBlueprintChanged {
+	if isIdentifierChanged && !isBlueprintChanged {
+		err = migrateRelations(ctx, r.portClient, state.Blueprint.ValueString(), previousState.Identifier.ValueString(), en.Identifier)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to migrate relations to the renamed entity", err.Error())
+			return
+		}
+	}
+
+	if isBlueprintChanged || isIdentifierChanged {
 		// Delete the old entity
 		err := r.portClient.DeleteEntity(ctx, previousState.Identifier.ValueString(), previousState.Blueprint.ValueString())
 		if err != nil {
@@ -165,7 +253,11 @@ func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 	}
 
-	writeEntityComputedFieldsToState(state, en)
+	err = writeEntityComputedFieldsToState(ctx, state, en)
+	if err != nil {
+		resp.Diagnostics.AddError("failed writing entity fields to resource", err.Error())
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
@@ -187,7 +279,8 @@ func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *EntityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ":")
+	// entity identifiers may contain colons, blueprint identifiers can't
+	idParts := strings.SplitN(req.ID, ":", 2)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError("invalid import ID", "import ID must be in the format <blueprint_id>:<entity_id>")
@@ -2,6 +2,8 @@ package entity_test
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -826,3 +828,390 @@ func TestAccPortEntityWithDefaultArrayProp(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortEntityManagedFieldsOnly(t *testing.T) {
+	identifier := utils.GenID()
+	entityIdentifier := utils.GenID()
+	teamName := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  {
+					"title" = "My String Identifier"
+				}
+				"myOtherStringIdentifier" =  {
+					"title" = "My Other String Identifier"
+				}
+			}
+		}
+	}
+	resource "port_team" "team" {
+		name = "%s"
+		description = "Test description"
+		users = []
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		identifier = "%s"
+		managed_fields_only = true
+		teams = [port_team.team.name]
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  "My String Value"
+				"myOtherStringIdentifier" =  "My Other String Value"
+			}
+		}
+	}`, identifier, teamName, entityIdentifier)
+
+	var testAccActionConfigUpdate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  {
+					"title" = "My String Identifier"
+				}
+				"myOtherStringIdentifier" =  {
+					"title" = "My Other String Identifier"
+				}
+			}
+		}
+	}
+	resource "port_team" "team" {
+		name = "%s"
+		description = "Test description"
+		users = []
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		identifier = "%s"
+		managed_fields_only = true
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  "My String Value2"
+			}
+		}
+	}`, identifier, teamName, entityIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "managed_fields_only", "true"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "properties.string_props.myStringIdentifier", "My String Value"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "properties.string_props.myOtherStringIdentifier", "My Other String Value"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "teams.#", "1"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "teams.0", teamName),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "managed_fields_only", "true"),
+					resource.TestCheckResourceAttr("port_entity.microservice", "properties.string_props.myStringIdentifier", "My String Value2"),
+					resource.TestCheckNoResourceAttr("port_entity.microservice", "properties.string_props.myOtherStringIdentifier"),
+					resource.TestCheckNoResourceAttr("port_entity.microservice", "teams.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortEntityPlanValidation(t *testing.T) {
+	identifier := utils.GenID()
+	var testAccBlueprintConfig = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  {
+					"title" = "My String Identifier"
+					"enum" = ["a", "b"]
+				}
+			}
+			"number_props" = {
+				"myNumberIdentifier" =  {
+					"title" = "My Number Identifier"
+					"maximum" = 10
+				}
+			}
+		}
+	}`, identifier)
+
+	var testAccInvalidEnumConfig = testAccBlueprintConfig + fmt.Sprintf(`
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  "c"
+			}
+		}
+	}`, identifier)
+
+	var testAccInvalidMaximumConfig = testAccBlueprintConfig + fmt.Sprintf(`
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = "%s"
+		properties = {
+			"number_props" = {
+				"myNumberIdentifier" =  11
+			}
+		}
+	}`, identifier)
+
+	var testAccUnknownPropertyConfig = testAccBlueprintConfig + fmt.Sprintf(`
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = "%s"
+		properties = {
+			"string_props" = {
+				"myUnknownIdentifier" =  "a"
+			}
+		}
+	}`, identifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccBlueprintConfig,
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccInvalidEnumConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("invalid enum value"),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccInvalidMaximumConfig,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("value too large"),
+			},
+			{
+				// properties missing from the blueprint are only warnings at plan time, as they may be added to the
+				// blueprint in the same apply, so the API rejects the entity
+				Config:      acctest.ProviderConfig + testAccUnknownPropertyConfig,
+				ExpectError: regexp.MustCompile("failed to create entity"),
+			},
+		},
+	})
+}
+
+func TestAccPortEntityPlanValidationWithBlueprintChange(t *testing.T) {
+	identifier := utils.GenID()
+	var testAccBlueprintConfig = `
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				%s
+			}
+		}
+	}`
+
+	var testAccEntityConfig = `
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			"string_props" = {
+				%s
+			}
+		}
+	}`
+
+	var testAccActionConfigCreate = fmt.Sprintf(testAccBlueprintConfig, identifier, `"myStringIdentifier" = { "title" = "My String Identifier" }`) +
+		fmt.Sprintf(testAccEntityConfig, `"myStringIdentifier" = "a"`)
+
+	// the entity sets a property that is added to the blueprint in the same apply
+	var testAccActionConfigUpdate = fmt.Sprintf(testAccBlueprintConfig, identifier, `
+				"myStringIdentifier" = { "title" = "My String Identifier" }
+				"myNewIdentifier" = { "title" = "My New Identifier" }`) +
+		fmt.Sprintf(testAccEntityConfig, `
+				"myStringIdentifier" = "a"
+				"myNewIdentifier" = "b"`)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "properties.string_props.myStringIdentifier", "a"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "properties.string_props.myNewIdentifier", "b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortEntityIdentifierTemplate(t *testing.T) {
+	identifier := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"region" =  {
+					"title" = "Region"
+				}
+			}
+		}
+	}
+	resource "port_blueprint" "deployment" {
+		title = "TF Provider Test BP1"
+		icon = "Terraform"
+		identifier = "%s-deployment"
+		properties = {}
+		relations = {
+			"microservice" = {
+				"title" = "Microservice"
+				"target" = port_blueprint.microservice.identifier
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		identifier_template = "{{ .title | slug }}-{{ .properties.region }}"
+		properties = {
+			"string_props" = {
+				"region" =  "eu"
+			}
+		}
+	}
+	resource "port_entity" "deployment" {
+		title = "TF Provider Test Entity1"
+		blueprint = port_blueprint.deployment.identifier
+		relations = {
+			"single_relations" = {
+				"microservice" = port_entity.microservice.identifier
+			}
+		}
+		lifecycle {
+			ignore_changes = [relations]
+		}
+	}`, identifier, identifier)
+
+	var testAccActionConfigUpdate = strings.Replace(testAccActionConfigCreate, `"region" =  "eu"`, `"region" =  "us"`, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "identifier", "tf-provider-test-entity0-eu"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "identifier", "tf-provider-test-entity0-us"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortEntityTimerProps(t *testing.T) {
+	identifier := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"ttl" =  {
+					"title" = "TTL"
+					"format" = "timer"
+				}
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		timer_props = {
+			"ttl" = {
+				expires_in = "72h"
+			}
+		}
+	}`, identifier)
+
+	var testAccActionConfigUpdateTitle = strings.Replace(testAccActionConfigCreate, `"TF Provider Test Entity0"`, `"TF Provider Test Entity1"`, 1)
+	var testAccActionConfigUpdateTimer = strings.Replace(testAccActionConfigUpdateTitle, `"72h"`, `"96h"`, 1)
+
+	var expiresAt string
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "timer_props.ttl.expires_in", "72h"),
+					resource.TestCheckResourceAttrWith("port_entity.microservice", "timer_props.ttl.expires_at", func(value string) error {
+						expiresAt = value
+						return nil
+					}),
+					resource.TestCheckNoResourceAttr("port_entity.microservice", "properties.string_props.ttl"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigUpdateTitle,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("port_entity.microservice", "timer_props.ttl.expires_at", func(value string) error {
+						if value != expiresAt {
+							return fmt.Errorf("expected the timer to keep expiring at %s, got %s", expiresAt, value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigUpdateTimer,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_entity.microservice", "timer_props.ttl.expires_in", "96h"),
+					resource.TestCheckResourceAttrWith("port_entity.microservice", "timer_props.ttl.expires_at", func(value string) error {
+						if value <= expiresAt {
+							return fmt.Errorf("expected the timer to expire after %s, got %s", expiresAt, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,27 +2,57 @@ package entity
 
 import (
 	"context"
+	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+var durationRegex = regexp.MustCompile(`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`)
+
+var scorecardType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"rules": types.ListType{
+			ElemType: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"identifier": types.StringType,
+					"status":     types.StringType,
+					"level":      types.StringType,
+				},
+			},
+		},
+		"level": types.StringType,
+	},
+}
+
 func EntitySchema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed: true,
 		},
 		"identifier": schema.StringAttribute{
-			MarkdownDescription: "The identifier of the entity",
+			MarkdownDescription: "The identifier of the entity, changing it renames the entity and updates the relations pointing to it",
 			Optional:            true,
 			Computed:            true,
 			PlanModifiers: []planmodifier.String{
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"identifier_template": schema.StringAttribute{
+			MarkdownDescription: "A [Go template](https://pkg.go.dev/text/template) used to generate the identifier of the entity from its `title`, `blueprint` and `properties`, e.g. `{{ .title | slug }}-{{ .properties.region }}`. The `lower`, `upper`, `trim` and `slug` functions are available. Changing the generated identifier renames the entity",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRoot("identifier")),
+			},
+		},
 		"title": schema.StringAttribute{
 			MarkdownDescription: "The title of the entity",
 			Optional:            true,
@@ -35,6 +65,12 @@ func EntitySchema() map[string]schema.Attribute {
 			MarkdownDescription: "The runID of the action run that created the entity",
 			Optional:            true,
 		},
+		"managed_fields_only": schema.BoolAttribute{
+			MarkdownDescription: "Whether to manage only the properties and relations declared in the resource, properties and relations set outside of Terraform (e.g. by an integration) are kept and ignored",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
 		"teams": schema.ListAttribute{
 			MarkdownDescription: "The teams the entity belongs to",
 			Optional:            true,
@@ -127,6 +163,38 @@ func EntitySchema() map[string]schema.Attribute {
 			MarkdownDescription: "The last updater of the entity",
 			Computed:            true,
 		},
+		"timer_props": schema.MapNestedAttribute{
+			MarkdownDescription: "The timer properties of the entity, set to a time relative to the apply that created or changed them",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"expires_in": schema.StringAttribute{
+						MarkdownDescription: "The time until the timer expires, as a duration (e.g. `72h`, `90m`)",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(durationRegex, "must be a duration, e.g. 72h or 90m"),
+						},
+					},
+					"expires_at": schema.StringAttribute{
+						MarkdownDescription: "The time the timer expires at",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"treat_timer_expiry_as_drift": schema.BoolAttribute{
+			MarkdownDescription: "Whether a timer that expired or was removed outside of Terraform is set again by the next apply",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		},
+		// Scorecard rules can check any field of the entity, so an update may change the levels and the previous
+		// scorecards can't be kept in the plan with UseStateForUnknown
+		"scorecards": schema.MapAttribute{
+			MarkdownDescription: "The scorecards of the entity, keyed by the scorecard identifier, with the level of the entity and the status of each rule. The scorecards are evaluated by Port after every change of the entity, so they are known only after apply whenever the entity is updated",
+			Computed:            true,
+			ElementType:         scorecardType,
+		},
 	}
 }
 
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// modifyPlanTimerProps keeps the expiration time of the timers whose expires_in didn't change, so updating other fields
+// of the entity doesn't reset them. The expiration time of new and changed timers is left unknown and computed during
+// the apply.
+func modifyPlanTimerProps(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var planTimers map[string]TimerPropModel
+	if diags := req.Plan.GetAttribute(ctx, path.Root("timer_props"), &planTimers); diags.HasError() || planTimers == nil {
+		return
+	}
+
+	var stateTimers map[string]TimerPropModel
+	if !req.State.Raw.IsNull() {
+		if diags := req.State.GetAttribute(ctx, path.Root("timer_props"), &stateTimers); diags.HasError() {
+			return
+		}
+	}
+
+	for identifier, timer := range planTimers {
+		stateTimer, ok := stateTimers[identifier]
+		if ok && !timer.ExpiresIn.IsUnknown() && timer.ExpiresIn.Equal(stateTimer.ExpiresIn) && !stateTimer.ExpiresAt.IsNull() {
+			timer.ExpiresAt = stateTimer.ExpiresAt
+		} else {
+			timer.ExpiresAt = types.StringUnknown()
+		}
+		planTimers[identifier] = timer
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("timer_props"), planTimers)...)
+}
+
+// resolveTimerProps computes the expiration time of the timers that are set or changed in this apply
+func resolveTimerProps(state *EntityModel, now time.Time) error {
+	for identifier, timer := range state.TimerProps {
+		if !timer.ExpiresAt.IsUnknown() {
+			continue
+		}
+
+		expiresIn, err := time.ParseDuration(timer.ExpiresIn.ValueString())
+		if err != nil {
+			return fmt.Errorf("invalid expires_in of timer %s: %s", identifier, err.Error())
+		}
+		timer.ExpiresAt = types.StringValue(now.Add(expiresIn).UTC().Format(time.RFC3339))
+		state.TimerProps[identifier] = timer
+	}
+	return nil
+}
+
+func writeTimerPropsToBody(state *EntityModel, properties map[string]interface{}) {
+	for identifier, timer := range state.TimerProps {
+		properties[identifier] = timer.ExpiresAt.ValueString()
+	}
+}
+
+// refreshTimerPropsState reads the timers of the entity and removes them from its properties, so they are not
+// refreshed into string_props as well. When treat_timer_expiry_as_drift is set, a timer that expired or was removed is
+// cleared from the state, so the next plan sets it again.
+func refreshTimerPropsState(state *EntityModel, e *cli.Entity, now time.Time) {
+	for identifier, timer := range state.TimerProps {
+		value, ok := e.Properties[identifier].(string)
+		delete(e.Properties, identifier)
+
+		expired := !ok
+		if expiresAt, err := time.Parse(time.RFC3339, value); ok && err == nil {
+			expired = !expiresAt.After(now)
+		}
+
+		if expired && state.TreatTimerExpiryAsDrift.ValueBool() {
+			timer.ExpiresAt = types.StringNull()
+		} else if ok {
+			timer.ExpiresAt = types.StringValue(value)
+		}
+		state.TimerProps[identifier] = timer
+	}
+}
+
+func validateTimerPropsAgainstBlueprint(state *EntityModel, b *cli.Blueprint, declared map[string]bool, diags *diag.Diagnostics) {
+	for identifier := range state.TimerProps {
+		p := path.Root("timer_props").AtMapKey(identifier)
+		if declared[identifier] {
+			diags.AddAttributeError(p, "duplicate property", fmt.Sprintf("property %s is set in both properties and timer_props", identifier))
+			continue
+		}
+		declared[identifier] = true
+
+		bp, ok := blueprintPropertyOfType(b, identifier, "string", p, diags)
+		if ok && (bp.Format == nil || *bp.Format != "timer") {
+			diags.AddAttributeError(p, "invalid timer property", fmt.Sprintf("property %s must be a string property with the timer format", identifier))
+		}
+	}
+}
@@ -0,0 +1,454 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// validateEntityAgainstBlueprint checks the entity against the deployed blueprint, so values the API would reject fail
+// the plan. Properties and relations that are missing from the blueprint are only warnings, as they may be added to the
+// blueprint in the same apply. Required properties and relations are not checked when the attributes that declare
+// them are unknown.
+func validateEntityAgainstBlueprint(state *EntityModel, b *cli.Blueprint, unknown map[string]bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	declared := make(map[string]bool)
+	if state.Properties != nil {
+		propertiesPath := path.Root("properties")
+
+		for identifier, prop := range state.Properties.StringProps {
+			declared[identifier] = true
+			p := propertiesPath.AtName("string_props").AtMapKey(identifier)
+			bp, ok := blueprintPropertyOfType(b, identifier, "string", p, &diags)
+			if !ok || prop.IsNull() || prop.IsUnknown() {
+				continue
+			}
+			validateStringValue(prop.ValueString(), bp, p, &diags)
+		}
+
+		for identifier, prop := range state.Properties.NumberProps {
+			declared[identifier] = true
+			p := propertiesPath.AtName("number_props").AtMapKey(identifier)
+			bp, ok := blueprintPropertyOfType(b, identifier, "number", p, &diags)
+			if !ok || prop.IsNull() || prop.IsUnknown() {
+				continue
+			}
+			validateNumberValue(prop.ValueFloat64(), bp, p, &diags)
+		}
+
+		for identifier := range state.Properties.BooleanProps {
+			declared[identifier] = true
+			blueprintPropertyOfType(b, identifier, "boolean", propertiesPath.AtName("boolean_props").AtMapKey(identifier), &diags)
+		}
+
+		for identifier, prop := range state.Properties.ObjectProps {
+			declared[identifier] = true
+			p := propertiesPath.AtName("object_props").AtMapKey(identifier)
+			if _, ok := blueprintPropertyOfType(b, identifier, "object", p, &diags); !ok || prop.IsNull() || prop.IsUnknown() {
+				continue
+			}
+			obj := make(map[string]interface{})
+			if err := json.Unmarshal([]byte(prop.ValueString()), &obj); err != nil {
+				diags.AddAttributeError(p, "invalid object property", fmt.Sprintf("property %s must be a JSON object: %s", identifier, err.Error()))
+			}
+		}
+
+		if state.Properties.ArrayProps != nil {
+			arrayPath := propertiesPath.AtName("array_props")
+			for itemsType, items := range map[string]types.Map{
+				"string":  state.Properties.ArrayProps.StringItems,
+				"number":  state.Properties.ArrayProps.NumberItems,
+				"boolean": state.Properties.ArrayProps.BooleanItems,
+				"object":  state.Properties.ArrayProps.ObjectItems,
+			} {
+				for identifier, list := range items.Elements() {
+					declared[identifier] = true
+					p := arrayPath.AtName(itemsType + "_items").AtMapKey(identifier)
+					bp, ok := blueprintPropertyOfType(b, identifier, "array", p, &diags)
+					if !ok {
+						continue
+					}
+					validateArrayValue(list, itemsType, bp, p, &diags)
+				}
+			}
+		}
+	}
+
+	validateTimerPropsAgainstBlueprint(state, b, declared, &diags)
+
+	// required properties can be set by other writers when only the declared fields are managed
+	if !state.ManagedFieldsOnly.ValueBool() && !unknown["properties"] && !unknown["timer_props"] {
+		for _, identifier := range b.Schema.Required {
+			if !declared[identifier] {
+				diags.AddAttributeError(path.Root("properties"), "missing required property",
+					fmt.Sprintf("property %s is required by blueprint %s", identifier, b.Identifier))
+			}
+		}
+	}
+
+	validateRelationsAgainstBlueprint(state, b, unknown["relations"], &diags)
+
+	return diags
+}
+
+func blueprintPropertyOfType(b *cli.Blueprint, identifier string, propertyType string, p path.Path, diags *diag.Diagnostics) (cli.BlueprintProperty, bool) {
+	bp, ok := b.Schema.Properties[identifier]
+	if !ok {
+		diags.AddAttributeWarning(p, "unknown property", fmt.Sprintf("property %s is not defined in blueprint %s", identifier, b.Identifier))
+		return bp, false
+	}
+
+	if bp.Type != propertyType {
+		diags.AddAttributeError(p, "invalid property type", fmt.Sprintf("property %s is of type %s in blueprint %s, not %s", identifier, bp.Type, b.Identifier, propertyType))
+		return bp, false
+	}
+
+	return bp, true
+}
+
+func validateStringValue(value string, bp cli.BlueprintProperty, p path.Path, diags *diag.Diagnostics) {
+	if len(bp.Enum) != 0 && !enumContains(bp.Enum, value) {
+		diags.AddAttributeError(p, "invalid enum value", fmt.Sprintf("%q is not one of %v", value, bp.Enum))
+	}
+
+	if bp.MinLength != nil && len(value) < *bp.MinLength {
+		diags.AddAttributeError(p, "value too short", fmt.Sprintf("%q is shorter than the minimum length of %d", value, *bp.MinLength))
+	}
+
+	if bp.MaxLength != nil && len(value) > *bp.MaxLength {
+		diags.AddAttributeError(p, "value too long", fmt.Sprintf("%q is longer than the maximum length of %d", value, *bp.MaxLength))
+	}
+
+	if bp.Pattern != nil {
+		// the blueprint patterns are ECMA regexes, skip the ones that RE2 can't compile instead of failing the plan
+		if re, err := regexp.Compile(*bp.Pattern); err == nil && !re.MatchString(value) {
+			diags.AddAttributeError(p, "value does not match pattern", fmt.Sprintf("%q does not match the pattern %s", value, *bp.Pattern))
+		}
+	}
+
+	if bp.Format != nil {
+		if err := validateStringFormat(value, *bp.Format); err != nil {
+			diags.AddAttributeError(p, "invalid format", fmt.Sprintf("%q is not a valid %s: %s", value, *bp.Format, err.Error()))
+		}
+	}
+}
+
+func validateStringFormat(value string, format string) error {
+	switch format {
+	case "date-time", "timer":
+		_, err := time.Parse(time.RFC3339, value)
+		return err
+	case "email", "user":
+		_, err := mail.ParseAddress(value)
+		return err
+	case "url":
+		u, err := url.Parse(value)
+		if err != nil {
+			return err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return fmt.Errorf("missing scheme or host")
+		}
+	case "ipv4":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("not an IPv4 address")
+		}
+	case "ipv6":
+		if ip := net.ParseIP(value); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("not an IPv6 address")
+		}
+	}
+	return nil
+}
+
+func validateNumberValue(value float64, bp cli.BlueprintProperty, p path.Path, diags *diag.Diagnostics) {
+	if len(bp.Enum) != 0 && !enumContains(bp.Enum, value) {
+		diags.AddAttributeError(p, "invalid enum value", fmt.Sprintf("%v is not one of %v", value, bp.Enum))
+	}
+
+	if bp.Minimum != nil && value < *bp.Minimum {
+		diags.AddAttributeError(p, "value too small", fmt.Sprintf("%v is less than the minimum of %v", value, *bp.Minimum))
+	}
+
+	if bp.Maximum != nil && value > *bp.Maximum {
+		diags.AddAttributeError(p, "value too large", fmt.Sprintf("%v is greater than the maximum of %v", value, *bp.Maximum))
+	}
+}
+
+func validateArrayValue(list attr.Value, itemsType string, bp cli.BlueprintProperty, p path.Path, diags *diag.Diagnostics) {
+	// array without items type is array of string by default
+	blueprintItemsType, ok := bp.Items["type"].(string)
+	if !ok {
+		blueprintItemsType = "string"
+	}
+	if blueprintItemsType != itemsType {
+		diags.AddAttributeError(p, "invalid array items type", fmt.Sprintf("items of this property are of type %s in the blueprint, not %s", blueprintItemsType, itemsType))
+		return
+	}
+
+	l, ok := list.(basetypes.ListValue)
+	if !ok || l.IsNull() || l.IsUnknown() {
+		return
+	}
+
+	count := len(l.Elements())
+	if bp.MinItems != nil && count < *bp.MinItems {
+		diags.AddAttributeError(p, "too few items", fmt.Sprintf("%d items is less than the minimum of %d", count, *bp.MinItems))
+	}
+	if bp.MaxItems != nil && count > *bp.MaxItems {
+		diags.AddAttributeError(p, "too many items", fmt.Sprintf("%d items is more than the maximum of %d", count, *bp.MaxItems))
+	}
+
+	itemsEnum, _ := bp.Items["enum"].([]interface{})
+	if len(itemsEnum) == 0 {
+		return
+	}
+	for i, item := range l.Elements() {
+		var value any
+		switch v := item.(type) {
+		case basetypes.StringValue:
+			if v.IsNull() || v.IsUnknown() {
+				continue
+			}
+			value = v.ValueString()
+		case basetypes.Float64Value:
+			if v.IsNull() || v.IsUnknown() {
+				continue
+			}
+			value = v.ValueFloat64()
+		default:
+			continue
+		}
+		if !enumContains(itemsEnum, value) {
+			diags.AddAttributeError(p.AtListIndex(i), "invalid enum value", fmt.Sprintf("%v is not one of %v", value, itemsEnum))
+		}
+	}
+}
+
+func enumContains(enum []any, value any) bool {
+	for _, e := range enum {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+func validateRelationsAgainstBlueprint(state *EntityModel, b *cli.Blueprint, relationsUnknown bool, diags *diag.Diagnostics) {
+	declared := make(map[string]bool)
+	if state.Relations != nil {
+		relationsPath := path.Root("relations")
+
+		for identifier := range state.Relations.SingleRelation {
+			declared[identifier] = true
+			validateRelationCardinality(b, identifier, false, relationsPath.AtName("single_relations").AtMapKey(identifier), diags)
+		}
+
+		for identifier := range state.Relations.ManyRelations {
+			declared[identifier] = true
+			validateRelationCardinality(b, identifier, true, relationsPath.AtName("many_relations").AtMapKey(identifier), diags)
+		}
+	}
+
+	if state.ManagedFieldsOnly.ValueBool() || relationsUnknown {
+		return
+	}
+
+	for identifier, relation := range b.Relations {
+		if relation.Required != nil && *relation.Required && !declared[identifier] {
+			diags.AddAttributeError(path.Root("relations"), "missing required relation",
+				fmt.Sprintf("relation %s is required by blueprint %s", identifier, b.Identifier))
+		}
+	}
+}
+
+func validateRelationCardinality(b *cli.Blueprint, identifier string, many bool, p path.Path, diags *diag.Diagnostics) {
+	relation, ok := b.Relations[identifier]
+	if !ok {
+		diags.AddAttributeWarning(p, "unknown relation", fmt.Sprintf("relation %s is not defined in blueprint %s", identifier, b.Identifier))
+		return
+	}
+
+	isMany := relation.Many != nil && *relation.Many
+	if isMany && !many {
+		diags.AddAttributeError(p, "invalid relation type", fmt.Sprintf("relation %s is a many relation, set it in many_relations", identifier))
+	}
+	if !isMany && many {
+		diags.AddAttributeError(p, "invalid relation type", fmt.Sprintf("relation %s is a single relation, set it in single_relations", identifier))
+	}
+}
+
+// relationTarget is a relation of the entity that points to a target entity, for reporting targets that don't exist
+type relationTarget struct {
+	relation string
+	path     path.Path
+}
+
+// validateRelationTargets checks that the entities the relations point to exist in the target blueprints of the
+// relations. The targets are searched once per target blueprint. Targets that don't exist yet may be created in the
+// same apply, and the search may fail for reasons that don't make the entity invalid, so both are reported as warnings.
+func validateRelationTargets(ctx context.Context, portClient *cli.PortClient, state *EntityModel, b *cli.Blueprint) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if state.Relations == nil {
+		return diags
+	}
+
+	// target blueprint -> target identifier -> the relations pointing to it
+	targets := make(map[string]map[string][]relationTarget)
+	addTarget := func(identifier string, target string, p path.Path) {
+		relation, ok := b.Relations[identifier]
+		if !ok || relation.Target == nil {
+			return
+		}
+		if targets[*relation.Target] == nil {
+			targets[*relation.Target] = make(map[string][]relationTarget)
+		}
+		targets[*relation.Target][target] = append(targets[*relation.Target][target], relationTarget{relation: identifier, path: p})
+	}
+
+	relationsPath := path.Root("relations")
+	for identifier, target := range state.Relations.SingleRelation {
+		if target == nil {
+			continue
+		}
+		addTarget(identifier, *target, relationsPath.AtName("single_relations").AtMapKey(identifier))
+	}
+	for identifier, many := range state.Relations.ManyRelations {
+		for _, target := range many {
+			addTarget(identifier, target, relationsPath.AtName("many_relations").AtMapKey(identifier))
+		}
+	}
+
+	for blueprint, entities := range targets {
+		identifiers := make([]string, 0, len(entities))
+		for identifier := range entities {
+			identifiers = append(identifiers, identifier)
+		}
+
+		existing, err := existingEntities(ctx, portClient, blueprint, identifiers)
+		if err != nil {
+			diags.AddAttributeWarning(relationsPath, "failed to read relation targets", fmt.Sprintf("the targets in blueprint %s were not checked: %s", blueprint, err.Error()))
+			continue
+		}
+
+		for identifier, relations := range entities {
+			if existing[identifier] {
+				continue
+			}
+			for _, r := range relations {
+				diags.AddAttributeWarning(r.path, "unknown relation target", fmt.Sprintf("entity %s was not found in blueprint %s, the target of relation %s", identifier, blueprint, r.relation))
+			}
+		}
+	}
+
+	return diags
+}
+
+// existingEntities returns which of the entities exist in the blueprint, with a single search
+func existingEntities(ctx context.Context, portClient *cli.PortClient, blueprint string, identifiers []string) (map[string]bool, error) {
+	query := map[string]any{
+		"combinator": "and",
+		"rules": []any{
+			map[string]any{
+				"property": "$blueprint",
+				"operator": "=",
+				"value":    blueprint,
+			},
+			map[string]any{
+				"property": "$identifier",
+				"operator": "in",
+				"value":    identifiers,
+			},
+		},
+	}
+	result, err := portClient.Search(ctx, &cli.SearchRequestQuery{
+		Query:   &query,
+		Include: []string{"$identifier"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]bool, len(result.Entities))
+	for _, e := range result.Entities {
+		existing[e.Identifier] = true
+	}
+	return existing, nil
+}
+
+// plannedRelationsModel reads the planned relations without failing on unknown targets
+type plannedRelationsModel struct {
+	SingleRelation types.Map `tfsdk:"single_relations"`
+	ManyRelations  types.Map `tfsdk:"many_relations"`
+}
+
+// plannedEntity reads the attributes of the planned entity that are validated against the blueprint. Attributes that
+// are unknown as a whole are left unset and returned as unknown, and unknown relation targets are left out, so the
+// known values are still validated.
+func plannedEntity(ctx context.Context, plan tfsdk.Plan) (*EntityModel, map[string]bool) {
+	state := &EntityModel{}
+	unknown := make(map[string]bool)
+
+	plan.GetAttribute(ctx, path.Root("blueprint"), &state.Blueprint)
+	plan.GetAttribute(ctx, path.Root("managed_fields_only"), &state.ManagedFieldsOnly)
+
+	if plan.GetAttribute(ctx, path.Root("properties"), &state.Properties).HasError() {
+		state.Properties = nil
+		unknown["properties"] = true
+	}
+	if plan.GetAttribute(ctx, path.Root("timer_props"), &state.TimerProps).HasError() {
+		state.TimerProps = nil
+		unknown["timer_props"] = true
+	}
+
+	var relations *plannedRelationsModel
+	if plan.GetAttribute(ctx, path.Root("relations"), &relations).HasError() {
+		unknown["relations"] = true
+		return state, unknown
+	}
+	if relations == nil {
+		return state, unknown
+	}
+	if relations.SingleRelation.IsUnknown() || relations.ManyRelations.IsUnknown() {
+		unknown["relations"] = true
+	}
+
+	state.Relations = &RelationModel{
+		SingleRelation: make(map[string]*string),
+		ManyRelations:  make(map[string][]string),
+	}
+	for identifier, v := range relations.SingleRelation.Elements() {
+		state.Relations.SingleRelation[identifier] = nil
+		if target, ok := v.(types.String); ok && !target.IsNull() && !target.IsUnknown() {
+			t := target.ValueString()
+			state.Relations.SingleRelation[identifier] = &t
+		}
+	}
+	for identifier, v := range relations.ManyRelations.Elements() {
+		targets := []string{}
+		if l, ok := v.(types.List); ok {
+			for _, e := range l.Elements() {
+				if target, ok := e.(types.String); ok && !target.IsNull() && !target.IsUnknown() {
+					targets = append(targets, target.ValueString())
+				}
+			}
+		}
+		state.Relations.ManyRelations[identifier] = targets
+	}
+
+	return state, unknown
+}
@@ -0,0 +1,14 @@
+package folder
+
+import (
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+func folderStateToPortBody(state *FolderModel) *cli.Folder {
+	return &cli.Folder{
+		Identifier: state.Identifier.ValueString(),
+		Title:      state.Title.ValueStringPointer(),
+		Parent:     state.Parent.ValueStringPointer(),
+		After:      state.After.ValueStringPointer(),
+	}
+}
@@ -0,0 +1,13 @@
+package folder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type FolderModel struct {
+	ID         types.String `tfsdk:"id"`
+	Identifier types.String `tfsdk:"identifier"`
+	Title      types.String `tfsdk:"title"`
+	Parent     types.String `tfsdk:"parent"`
+	After      types.String `tfsdk:"after"`
+}
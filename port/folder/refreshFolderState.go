@@ -0,0 +1,18 @@
+package folder
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// refreshFolderState writes the folder to the state. The position of the folder is only refreshed when the folder
+// sets after itself, so folders ordered by a port_sidebar_order don't drift.
+func refreshFolderState(state *FolderModel, f *cli.Folder) {
+	state.ID = types.StringValue(f.Identifier)
+	state.Identifier = types.StringValue(f.Identifier)
+	state.Title = types.StringPointerValue(f.Title)
+	state.Parent = types.StringPointerValue(f.Parent)
+	if !state.After.IsNull() {
+		state.After = types.StringPointerValue(f.After)
+	}
+}
@@ -0,0 +1,139 @@
+package folder
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+var _ resource.Resource = &FolderResource{}
+var _ resource.ResourceWithImportState = &FolderResource{}
+
+func NewFolderResource() resource.Resource {
+	return &FolderResource{}
+}
+
+type FolderResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *FolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *FolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *FolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *FolderModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	f, statusCode, err := r.portClient.GetFolder(ctx, state.Identifier.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read folder", err.Error())
+		return
+	}
+
+	refreshFolderState(state, f)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *FolderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.portClient.CreateFolder(ctx, folderStateToPortBody(state))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create folder", err.Error())
+		return
+	}
+
+	state.ID = state.Identifier
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *FolderModel
+	var previousState *FolderModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &previousState)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.portClient.UpdateFolder(ctx, state.Identifier.ValueString(), folderStateToPortBody(state))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update folder", err.Error())
+		return
+	}
+
+	// Unset fields are left out of the update, so moving the folder back to the root of the sidebar is a move of
+	// its own
+	if state.Parent.IsNull() && !previousState.Parent.IsNull() {
+		item := cli.SidebarItem{Identifier: state.Identifier.ValueString(), SidebarType: consts.SidebarFolder}
+		err = r.portClient.MoveSidebarItem(ctx, item, cli.SidebarItemPosition{After: state.After.ValueStringPointer()})
+		if err != nil {
+			resp.Diagnostics.AddError("failed to move folder to the root of the sidebar", err.Error())
+			return
+		}
+	}
+
+	state.ID = state.Identifier
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state *FolderModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	statusCode, err := r.portClient.DeleteFolder(ctx, state.Identifier.ValueString())
+	if err != nil {
+		if statusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to delete folder", err.Error())
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *FolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("identifier"), req.ID,
+	)...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+}
@@ -0,0 +1,66 @@
+package folder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func TestAccPortFolder(t *testing.T) {
+	parentIdentifier := utils.GenID()
+	folderIdentifier := utils.GenID()
+	var testAccFolderConfigCreate = fmt.Sprintf(`
+	resource "port_folder" "parent" {
+		identifier = "%s"
+		title = "Parent"
+	}
+
+	resource "port_folder" "folder" {
+		identifier = "%s"
+		title = "Folder"
+		parent = port_folder.parent.identifier
+	}`, parentIdentifier, folderIdentifier)
+
+	var testAccFolderConfigUpdate = fmt.Sprintf(`
+	resource "port_folder" "parent" {
+		identifier = "%s"
+		title = "Parent"
+	}
+
+	resource "port_folder" "folder" {
+		identifier = "%s"
+		title = "Updated Folder"
+	}`, parentIdentifier, folderIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccFolderConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_folder.folder", "id", folderIdentifier),
+					resource.TestCheckResourceAttr("port_folder.folder", "identifier", folderIdentifier),
+					resource.TestCheckResourceAttr("port_folder.folder", "title", "Folder"),
+					resource.TestCheckResourceAttr("port_folder.folder", "parent", parentIdentifier),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccFolderConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_folder.folder", "title", "Updated Folder"),
+					resource.TestCheckNoResourceAttr("port_folder.folder", "parent"),
+				),
+			},
+			{
+				ResourceName:      "port_folder.folder",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     folderIdentifier,
+			},
+		},
+	})
+}
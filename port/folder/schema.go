@@ -0,0 +1,87 @@
+package folder
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+func FolderSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the folder",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the folder",
+			Optional:            true,
+		},
+		"parent": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the folder in which the folder is in, default is the root of the sidebar",
+			Optional:            true,
+		},
+		"after": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the page/folder after which the folder should be placed. Leave it unset when the folder is ordered by a `port_sidebar_order`. The position is only read back from Port when `after` is set, so moving a folder that doesn't set `after` isn't detected as drift, and importing a folder doesn't set `after`",
+			Optional:            true,
+		},
+	}
+}
+
+func (r *FolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceMarkdownDescription,
+		Attributes:          FolderSchema(),
+	}
+}
+
+var ResourceMarkdownDescription = `
+
+# Folder
+
+Manages a folder of the catalog sidebar. Pages and other folders are placed in the folder by setting their ` + "`parent`" + ` to the identifier of the folder.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_folder" "engineering" {
+  identifier = "engineering"
+  title      = "Engineering"
+}
+
+resource "port_folder" "services" {
+  identifier = "services"
+  title      = "Services"
+  parent     = port_folder.engineering.identifier
+}
+
+resource "port_page" "microservices" {
+  identifier = "microservices"
+  title      = "Microservices"
+  type       = "blueprint-entities"
+  blueprint  = "microservice"
+  parent     = port_folder.services.identifier
+}
+
+` + "```" + `
+
+## Import
+
+Folders are imported by their identifier:
+
+` + "```shell" + `
+terraform import port_folder.engineering engineering
+` + "```" + `
+`
@@ -3,19 +3,116 @@ package page
 import "github.com/hashicorp/terraform-plugin-framework/types"
 
 type PageModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Identifier   types.String   `tfsdk:"identifier"`
+	Title        types.String   `tfsdk:"title"`
+	Type         types.String   `tfsdk:"type"`
+	Parent       types.String   `tfsdk:"parent"`
+	After        types.String   `tfsdk:"after"`
+	Icon         types.String   `tfsdk:"icon"`
+	Locked       types.Bool     `tfsdk:"locked"`
+	Blueprint    types.String   `tfsdk:"blueprint"`
+	Widgets      []types.String `tfsdk:"widgets"`
+	TypedWidgets []WidgetModel  `tfsdk:"typed_widgets"`
+	CreatedAt    types.String   `tfsdk:"created_at"`
+	CreatedBy    types.String   `tfsdk:"created_by"`
+	UpdatedAt    types.String   `tfsdk:"updated_at"`
+	UpdatedBy    types.String   `tfsdk:"updated_by"`
+	Description  types.String   `tfsdk:"description"`
+}
+
+type TableEntitiesExplorerWidgetModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Blueprint   types.String `tfsdk:"blueprint"`
+	Dataset     types.String `tfsdk:"dataset"`
+}
+
+type EntitiesPieChartWidgetModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Blueprint   types.String `tfsdk:"blueprint"`
+	Property    types.String `tfsdk:"property"`
+	Dataset     types.String `tfsdk:"dataset"`
+}
+
+type EntitiesNumberChartWidgetModel struct {
+	ID            types.String `tfsdk:"id"`
+	Title         types.String `tfsdk:"title"`
+	Icon          types.String `tfsdk:"icon"`
+	Description   types.String `tfsdk:"description"`
+	Blueprint     types.String `tfsdk:"blueprint"`
+	CalculationBy types.String `tfsdk:"calculation_by"`
+	Func          types.String `tfsdk:"func"`
+	Property      types.String `tfsdk:"property"`
+	AverageOf     types.String `tfsdk:"average_of"`
+	Unit          types.String `tfsdk:"unit"`
+	UnitCustom    types.String `tfsdk:"unit_custom"`
+	Dataset       types.String `tfsdk:"dataset"`
+}
+
+type MarkdownWidgetModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Markdown    types.String `tfsdk:"markdown"`
+}
+
+type IframeWidgetModel struct {
+	ID          types.String `tfsdk:"id"`
+	Title       types.String `tfsdk:"title"`
+	Icon        types.String `tfsdk:"icon"`
+	Description types.String `tfsdk:"description"`
+	Url         types.String `tfsdk:"url"`
+	UrlType     types.String `tfsdk:"url_type"`
+}
+
+type ActionCardWidgetModel struct {
 	ID          types.String   `tfsdk:"id"`
-	Identifier  types.String   `tfsdk:"identifier"`
 	Title       types.String   `tfsdk:"title"`
-	Type        types.String   `tfsdk:"type"`
-	Parent      types.String   `tfsdk:"parent"`
-	After       types.String   `tfsdk:"after"`
 	Icon        types.String   `tfsdk:"icon"`
-	Locked      types.Bool     `tfsdk:"locked"`
-	Blueprint   types.String   `tfsdk:"blueprint"`
-	Widgets     []types.String `tfsdk:"widgets"`
-	CreatedAt   types.String   `tfsdk:"created_at"`
-	CreatedBy   types.String   `tfsdk:"created_by"`
-	UpdatedAt   types.String   `tfsdk:"updated_at"`
-	UpdatedBy   types.String   `tfsdk:"updated_by"`
 	Description types.String   `tfsdk:"description"`
+	Actions     []types.String `tfsdk:"actions"`
+}
+
+type DashboardColumnModel struct {
+	ID   types.String `tfsdk:"id"`
+	Size types.Int64  `tfsdk:"size"`
+}
+
+type DashboardRowModel struct {
+	Height  types.Int64            `tfsdk:"height"`
+	Columns []DashboardColumnModel `tfsdk:"columns"`
+}
+
+type DashboardChildWidgetModel struct {
+	TableEntitiesExplorer *TableEntitiesExplorerWidgetModel `tfsdk:"table_entities_explorer"`
+	EntitiesPieChart      *EntitiesPieChartWidgetModel      `tfsdk:"entities_pie_chart"`
+	EntitiesNumberChart   *EntitiesNumberChartWidgetModel   `tfsdk:"entities_number_chart"`
+	Markdown              *MarkdownWidgetModel              `tfsdk:"markdown"`
+	Iframe                *IframeWidgetModel                `tfsdk:"iframe"`
+	ActionCard            *ActionCardWidgetModel            `tfsdk:"action_card"`
+	Json                  types.String                      `tfsdk:"json"`
+}
+
+type DashboardWidgetModel struct {
+	ID      types.String                `tfsdk:"id"`
+	Layout  []DashboardRowModel         `tfsdk:"layout"`
+	Widgets []DashboardChildWidgetModel `tfsdk:"widgets"`
+}
+
+type WidgetModel struct {
+	TableEntitiesExplorer *TableEntitiesExplorerWidgetModel `tfsdk:"table_entities_explorer"`
+	EntitiesPieChart      *EntitiesPieChartWidgetModel      `tfsdk:"entities_pie_chart"`
+	EntitiesNumberChart   *EntitiesNumberChartWidgetModel   `tfsdk:"entities_number_chart"`
+	Markdown              *MarkdownWidgetModel              `tfsdk:"markdown"`
+	Iframe                *IframeWidgetModel                `tfsdk:"iframe"`
+	ActionCard            *ActionCardWidgetModel            `tfsdk:"action_card"`
+	Json                  types.String                      `tfsdk:"json"`
+	Dashboard             *DashboardWidgetModel             `tfsdk:"dashboard"`
 }
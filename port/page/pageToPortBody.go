@@ -25,6 +25,14 @@ func PageToPortBody(pm *PageModel) (*cli.Page, error) {
 	}
 	pb.Widgets = widgets
 
+	if pm.TypedWidgets != nil {
+		typedWidgets, err := typedWidgetsToPortBody(pm.TypedWidgets)
+		if err != nil {
+			return nil, err
+		}
+		pb.Widgets = typedWidgets
+	}
+
 	return pb, nil
 }
 
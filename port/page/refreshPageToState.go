@@ -1,7 +1,6 @@
 package page
 
 import (
-	"encoding/json"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 )
@@ -12,22 +11,44 @@ func refreshPageToState(pm *PageModel, b *cli.Page) error {
 	pm.Type = types.StringValue(b.Type)
 	pm.Icon = types.StringPointerValue(b.Icon)
 	pm.Parent = types.StringPointerValue(b.Parent)
-	pm.After = types.StringPointerValue(b.After)
+	// The position of the page is only refreshed when the page sets after itself, so pages ordered by a
+	// port_sidebar_order don't drift
+	if !pm.After.IsNull() {
+		pm.After = types.StringPointerValue(b.After)
+	}
 	pm.Title = types.StringPointerValue(b.Title)
 	pm.Locked = types.BoolPointerValue(b.Locked)
 	pm.Blueprint = types.StringPointerValue(b.Blueprint)
 	pm.Description = types.StringPointerValue(b.Description)
 
-	pm.Widgets = make([]types.String, len(*b.Widgets))
-	if b.Widgets != nil {
-		// go over each widget and convert it to a string and store it in the widgets array
-		for i, widget := range *b.Widgets {
-			bWidget, err := json.Marshal(widget)
-			if err != nil {
-				return err
-			}
-			pm.Widgets[i] = types.StringValue(string(bWidget))
+	if b.Widgets == nil {
+		pm.Widgets = nil
+		pm.TypedWidgets = nil
+		return nil
+	}
+
+	if pm.TypedWidgets != nil {
+		typedWidgets, err := writeTypedWidgetsToResource(*b.Widgets, pm.TypedWidgets)
+		if err != nil {
+			return err
+		}
+		pm.TypedWidgets = typedWidgets
+		return nil
+	}
+
+	widgets := make([]types.String, len(*b.Widgets))
+	// go over each widget and convert it to a string, keeping the widget in the state when it is semantically equal
+	for i, widget := range *b.Widgets {
+		stateWidget := types.StringNull()
+		if i < len(pm.Widgets) {
+			stateWidget = pm.Widgets[i]
+		}
+		w, err := refreshJSON(stateWidget, widget)
+		if err != nil {
+			return err
 		}
+		widgets[i] = w
 	}
+	pm.Widgets = widgets
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 )
 
 var _ resource.Resource = &PageResource{}
@@ -82,7 +83,7 @@ func (r *PageResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	if state.Identifier.ValueString() == "$home" {
+	if state.Identifier.ValueString() == consts.HomePageIdentifier {
 		tflog.Debug(ctx, "$home page is not deletable, unregistering from state")
 		resp.State.RemoveResource(ctx)
 		return
@@ -115,7 +116,13 @@ func (r *PageResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	p, err := r.portClient.CreatePage(ctx, page)
+	var p *cli.Page
+	if page.Identifier == consts.HomePageIdentifier {
+		// The home page exists in every organization and can't be created, so the existing home page is updated
+		p, err = r.createHomePage(ctx, page)
+	} else {
+		p, err = r.portClient.CreatePage(ctx, page)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create page", err.Error())
 		return
@@ -161,6 +168,11 @@ func (r *PageResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
+	// Pages without after keep their position, which may be set by a port_sidebar_order
+	if page.After == nil {
+		page.After = p.After
+	}
+
 	_, err = r.portClient.UpdatePage(ctx, p.Identifier, page)
 
 	if err != nil {
@@ -178,3 +190,11 @@ func (r *PageResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 }
+
+func (r *PageResource) createHomePage(ctx context.Context, page *cli.Page) (*cli.Page, error) {
+	if _, err := r.portClient.UpdatePage(ctx, consts.HomePageIdentifier, page); err != nil {
+		return nil, err
+	}
+	p, _, err := r.portClient.GetPage(ctx, consts.HomePageIdentifier)
+	return p, err
+}
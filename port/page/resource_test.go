@@ -307,3 +307,160 @@ resource "port_page" "microservice_dashboard_page_2" {
 		},
 	})
 }
+
+func TestAccPortPageResourceTypedWidgets(t *testing.T) {
+	blueprintIdentifier := utils.GenID()
+	pageIdentifier := utils.GenID()
+	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var testAccPortPageResourceTypedWidgets = testAccCreateBlueprintConfig(blueprintIdentifier) + fmt.Sprintf(`
+
+resource "port_page" "microservice_dashboard_page" {
+  identifier            = "%s"
+  title                 = "dashboards"
+  icon                  = "GitHub"
+  type                  = "dashboard"
+  typed_widgets         = [
+    {
+      dashboard = {
+        id     = "dashboardWidget"
+        layout = [
+          {
+            height  = 400
+            columns = [
+              {
+                id   = "microserviceGuide"
+                size = 6
+              },
+              {
+                id   = "microservicesCount"
+                size = 6
+              }
+            ]
+          }
+        ]
+        widgets = [
+          {
+            markdown = {
+              id       = "microserviceGuide"
+              title    = "Microservices Guide"
+              icon     = "BlankPage"
+              markdown = "# This is the new Microservice Dashboard"
+            }
+          },
+          {
+            entities_number_chart = {
+              id             = "microservicesCount"
+              title          = "Microservices"
+              blueprint      = port_blueprint.microservice.identifier
+              calculation_by = "entities"
+              func           = "count"
+              unit           = "none"
+              dataset = jsonencode({
+                combinator = "and"
+                rules = [
+                  {
+                    operator = "="
+                    property = "$blueprint"
+                    value    = port_blueprint.microservice.identifier
+                  }
+                ]
+              })
+            }
+          },
+          {
+            json = jsonencode({
+              type    = "iframe-widget"
+              title   = "Overview"
+              url     = "https://example.com"
+              urlType = "public"
+            })
+          }
+        ]
+      }
+    }
+  ]
+}
+`, pageIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccPortPageResourceTypedWidgets,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "identifier", pageIdentifier),
+					resource.TestCheckNoResourceAttr("port_page.microservice_dashboard_page", "widgets.#"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.#", "1"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.0.dashboard.layout.0.columns.#", "2"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.0.dashboard.widgets.#", "3"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.0.dashboard.widgets.0.markdown.title", "Microservices Guide"),
+					resource.TestCheckResourceAttr("port_page.microservice_dashboard_page", "typed_widgets.0.dashboard.widgets.1.entities_number_chart.func", "count"),
+					resource.TestCheckResourceAttrSet("port_page.microservice_dashboard_page", "typed_widgets.0.dashboard.widgets.2.json"),
+				),
+			},
+			{
+				Config:   acctest.ProviderConfig + testAccPortPageResourceTypedWidgets,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPortPageResourceTypedWidgetWithoutType(t *testing.T) {
+	pageIdentifier := utils.GenID()
+	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var testAccPortPageResourceTypedWidgets = fmt.Sprintf(`
+
+resource "port_page" "microservice_dashboard_page" {
+  identifier            = "%s"
+  title                 = "dashboards"
+  type                  = "dashboard"
+  typed_widgets         = [{}]
+}
+`, pageIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccPortPageResourceTypedWidgets,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccPortPageResourceHomePageInvalidIdentifier(t *testing.T) {
+	pageIdentifier := utils.GenID()
+	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var testAccPortPageResourceHome = fmt.Sprintf(`
+
+resource "port_page" "home_page" {
+  identifier            = "%s"
+  title                 = "Home"
+  type                  = "home"
+}
+`, pageIdentifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccPortPageResourceHome,
+				ExpectError: regexp.MustCompile("invalid home page"),
+			},
+		},
+	})
+}
@@ -2,13 +2,17 @@ package page
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 	"os"
 )
 
@@ -37,7 +41,7 @@ func PageSchema() map[string]schema.Attribute {
 			Optional:    true,
 		},
 		"after": schema.StringAttribute{
-			Description: "The identifier of the page/folder after which the page should be placed",
+			Description: "The identifier of the page/folder after which the page should be placed. Leave it unset when the page is ordered by a \"port_sidebar_order\". The position is only read back from Port when after is set, so moving a page that doesn't set after isn't detected as drift, and importing a page doesn't set after",
 			Optional:    true,
 		},
 		"icon": schema.StringAttribute{
@@ -57,10 +61,14 @@ func PageSchema() map[string]schema.Attribute {
 			Optional:    true,
 		},
 		"widgets": schema.ListAttribute{
-			Description: "The widgets of the page",
+			Description: "The widgets of the page as JSON strings. They are compared to the widgets in Port semantically, ignoring key order and ids added by Port",
 			Optional:    true,
 			ElementType: types.StringType,
+			Validators: []validator.List{
+				listvalidator.ConflictsWith(path.MatchRoot("typed_widgets")),
+			},
 		},
+		"typed_widgets": TypedWidgetsSchema(),
 		"created_at": schema.StringAttribute{
 			MarkdownDescription: "The creation date of the page",
 			Computed:            true,
@@ -110,6 +118,15 @@ func (r *PageResource) ValidateConfig(ctx context.Context, req resource.Validate
 		resp.Diagnostics.AddError("Beta features are not enabled", "Page resource is currently in beta and is subject to change in future versions. Use it by setting the Environment Variable PORT_BETA_FEATURES_ENABLED=true.")
 		return
 	}
+
+	isHomePage := state.Identifier.ValueString() == consts.HomePageIdentifier
+	if !state.Type.IsUnknown() && !state.Identifier.IsUnknown() && (state.Type.ValueString() == "home") != isHomePage {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "invalid home page", fmt.Sprintf("the home page is the page with the identifier %s and the type home", consts.HomePageIdentifier))
+		return
+	}
+	if isHomePage && (!state.Parent.IsNull() || !state.After.IsNull()) {
+		resp.Diagnostics.AddAttributeError(path.Root("parent"), "invalid home page", "the home page is always first in the sidebar, it can't set parent or after")
+	}
 }
 
 var PageResourceMarkdownDescription = `
@@ -200,6 +217,61 @@ resource "port_page" "microservice_dashboard_page" {
 ` + "```" + `
 
 
+### Dashboard Page With Typed Widgets
+
+Widgets can also be set as ` + "`typed_widgets`" + `, with a typed block for each of the common widget types and ` + "`json`" + ` for any other widget.
+
+` + "```hcl" + `
+
+resource "port_page" "microservice_dashboard_page" {
+  identifier            = "microservice_dashboard_page"
+  title                 = "Microservices"
+  icon                  = "GitHub"
+  type                  = "dashboard"
+  typed_widgets         = [
+    {
+      dashboard = {
+        id     = "dashboardWidget"
+        layout = [
+          {
+            height  = 400
+            columns = [
+              {
+                id   = "microserviceGuide"
+                size = 6
+              },
+              {
+                id   = "microservicesByLanguage"
+                size = 6
+              }
+            ]
+          }
+        ]
+        widgets = [
+          {
+            markdown = {
+              id       = "microserviceGuide"
+              title    = "Microservices Guide"
+              icon     = "BlankPage"
+              markdown = "# This is the new Microservice Dashboard"
+            }
+          },
+          {
+            entities_pie_chart = {
+              id        = "microservicesByLanguage"
+              title     = "Microservices by language"
+              blueprint = port_blueprint.microservice.identifier
+              property  = "property#language"
+            }
+          }
+        ]
+      }
+    }
+  ]
+}
+
+` + "```" + `
+
 ### Page with parent
 
 Create a page inside a folder.
@@ -346,7 +418,8 @@ resource "port_page" "home_page" {
 The home page is a special page, which is created by default when you create a new organization.
 
 - When deleting the home page resource using terraform, the home page will not be deleted from Port as it isn't deletable page, instead, the home page will be removed from the terraform state.
-- Due to only having one home page you'll have to import the state of the home page manually.
+- Creating the home page resource updates the existing home page instead of creating a new one, the home page must have the identifier ` + "`$home`" + ` and can't set ` + "`parent`" + ` or ` + "`after`" + `.
+- The state of the home page can also be imported:
 
 ` + "```" + `
 terraform import port_page.home_page "\$home"
@@ -0,0 +1,231 @@
+package page
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+// widgetTypes are the attributes of a typed widget, exactly one of which is set
+var widgetTypes = []string{
+	"table_entities_explorer",
+	"entities_pie_chart",
+	"entities_number_chart",
+	"markdown",
+	"iframe",
+	"action_card",
+	"json",
+}
+
+func widgetCommonAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the widget, referenced by the layout of dashboards",
+			Required:            true,
+		},
+		"title": schema.StringAttribute{
+			MarkdownDescription: "The title of the widget",
+			Optional:            true,
+		},
+		"icon": schema.StringAttribute{
+			MarkdownDescription: "The icon of the widget",
+			Optional:            true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the widget",
+			Optional:            true,
+		},
+	}
+}
+
+func widgetDatasetAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "The dataset of the entities of the widget as a JSON string, with a combinator and rules",
+		Optional:            true,
+	}
+}
+
+func widgetAttribute(description string, attributes map[string]schema.Attribute) schema.SingleNestedAttribute {
+	utils.CopyMaps(attributes, widgetCommonAttributes())
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes:          attributes,
+	}
+}
+
+// widgetSchema returns the attributes of a typed widget. Dashboards can only be used at the top level of the page, as
+// the widgets of a dashboard can't be dashboards themselves.
+func widgetSchema(allowDashboard bool) map[string]schema.Attribute {
+	names := widgetTypes
+	if allowDashboard {
+		names = append(append([]string{}, widgetTypes...), "dashboard")
+	}
+	others := make([]path.Expression, 0, len(names)-1)
+	for _, name := range names {
+		if name != "json" {
+			others = append(others, path.MatchRelative().AtParent().AtName(name))
+		}
+	}
+
+	widget := map[string]schema.Attribute{
+		"table_entities_explorer": widgetAttribute("A table of entities widget", map[string]schema.Attribute{
+			"blueprint": schema.StringAttribute{
+				MarkdownDescription: "The blueprint of the entities of the table",
+				Optional:            true,
+			},
+			"dataset": widgetDatasetAttribute(),
+		}),
+		"entities_pie_chart": widgetAttribute("A pie chart of entities widget, grouped by a property", map[string]schema.Attribute{
+			"blueprint": schema.StringAttribute{
+				MarkdownDescription: "The blueprint of the entities of the chart",
+				Required:            true,
+			},
+			"property": schema.StringAttribute{
+				MarkdownDescription: "The property the entities are grouped by, e.g. `property#language`",
+				Required:            true,
+			},
+			"dataset": widgetDatasetAttribute(),
+		}),
+		"entities_number_chart": widgetAttribute("A number chart widget, calculated from the entities or one of their properties", map[string]schema.Attribute{
+			"blueprint": schema.StringAttribute{
+				MarkdownDescription: "The blueprint of the entities of the chart",
+				Required:            true,
+			},
+			"calculation_by": schema.StringAttribute{
+				MarkdownDescription: "What the number is calculated by, `entities` or `property`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("entities", "property"),
+				},
+			},
+			"func": schema.StringAttribute{
+				MarkdownDescription: "The function the number is calculated with, e.g. `count`, `sum` or `average`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("count", "sum", "average", "min", "max", "median"),
+				},
+			},
+			"property": schema.StringAttribute{
+				MarkdownDescription: "The property the number is calculated by, when `calculation_by` is `property`",
+				Optional:            true,
+			},
+			"average_of": schema.StringAttribute{
+				MarkdownDescription: "The time frame of the average, when `func` is `average`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("hour", "day", "week", "month", "total"),
+				},
+			},
+			"unit": schema.StringAttribute{
+				MarkdownDescription: "The unit of the number, e.g. `none`, `%`, `$` or `custom`",
+				Optional:            true,
+			},
+			"unit_custom": schema.StringAttribute{
+				MarkdownDescription: "The custom unit of the number, when `unit` is `custom`",
+				Optional:            true,
+			},
+			"dataset": widgetDatasetAttribute(),
+		}),
+		"markdown": widgetAttribute("A markdown widget", map[string]schema.Attribute{
+			"markdown": schema.StringAttribute{
+				MarkdownDescription: "The markdown content of the widget",
+				Required:            true,
+			},
+		}),
+		"iframe": widgetAttribute("An iframe widget", map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The url of the iframe",
+				Required:            true,
+			},
+			"url_type": schema.StringAttribute{
+				MarkdownDescription: "The type of the url, `public` or `protected`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "protected"),
+				},
+			},
+		}),
+		"action_card": widgetAttribute("An action card widget, to execute self service actions from the page", map[string]schema.Attribute{
+			"actions": schema.ListAttribute{
+				MarkdownDescription: "The identifiers of the actions of the card",
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+		}),
+		"json": schema.StringAttribute{
+			MarkdownDescription: "A widget of any other type as a JSON string. It is compared to the widget in Port semantically, ignoring key order and ids added by Port",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(others...),
+			},
+		},
+	}
+
+	if allowDashboard {
+		widget["dashboard"] = schema.SingleNestedAttribute{
+			MarkdownDescription: "A dashboard of widgets, arranged in a grid layout",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "The identifier of the dashboard widget",
+					Required:            true,
+				},
+				"layout": schema.ListNestedAttribute{
+					MarkdownDescription: "The rows of the grid layout of the dashboard",
+					Required:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"height": schema.Int64Attribute{
+								MarkdownDescription: "The height of the row in pixels",
+								Required:            true,
+							},
+							"columns": schema.ListNestedAttribute{
+								MarkdownDescription: "The columns of the row",
+								Required:            true,
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"id": schema.StringAttribute{
+											MarkdownDescription: "The identifier of the widget shown in the column",
+											Required:            true,
+										},
+										"size": schema.Int64Attribute{
+											MarkdownDescription: "The width of the column, out of 12",
+											Required:            true,
+											Validators: []validator.Int64{
+												int64validator.Between(1, 12),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"widgets": schema.ListNestedAttribute{
+					MarkdownDescription: "The widgets of the dashboard",
+					Required:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: widgetSchema(false),
+					},
+				},
+			},
+		}
+	}
+
+	return widget
+}
+
+func TypedWidgetsSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: "The widgets of the page as typed widgets, an alternative to the JSON `widgets`. Each widget sets exactly one of the widget types, or `json` for other widgets",
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: widgetSchema(true),
+		},
+	}
+}
@@ -0,0 +1,447 @@
+package page
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func (w DashboardChildWidgetModel) widget() WidgetModel {
+	return WidgetModel{
+		TableEntitiesExplorer: w.TableEntitiesExplorer,
+		EntitiesPieChart:      w.EntitiesPieChart,
+		EntitiesNumberChart:   w.EntitiesNumberChart,
+		Markdown:              w.Markdown,
+		Iframe:                w.Iframe,
+		ActionCard:            w.ActionCard,
+		Json:                  w.Json,
+	}
+}
+
+func (w WidgetModel) dashboardChild() DashboardChildWidgetModel {
+	return DashboardChildWidgetModel{
+		TableEntitiesExplorer: w.TableEntitiesExplorer,
+		EntitiesPieChart:      w.EntitiesPieChart,
+		EntitiesNumberChart:   w.EntitiesNumberChart,
+		Markdown:              w.Markdown,
+		Iframe:                w.Iframe,
+		ActionCard:            w.ActionCard,
+		Json:                  w.Json,
+	}
+}
+
+func setOptionalString(body map[string]any, key string, value types.String) {
+	if !value.IsNull() {
+		body[key] = value.ValueString()
+	}
+}
+
+func setWidgetDataset(body map[string]any, dataset types.String) error {
+	if dataset.IsNull() {
+		return nil
+	}
+	v, err := utils.TerraformJsonStringToGoObject(dataset.ValueStringPointer())
+	if err != nil {
+		return err
+	}
+	body["dataset"] = *v
+	return nil
+}
+
+func newWidgetBody(widgetType string, id, title, icon, description types.String) map[string]any {
+	body := map[string]any{
+		"type": widgetType,
+		"id":   id.ValueString(),
+	}
+	setOptionalString(body, "title", title)
+	setOptionalString(body, "icon", icon)
+	setOptionalString(body, "description", description)
+	return body
+}
+
+func typedWidgetsToPortBody(widgets []WidgetModel) (*[]map[string]any, error) {
+	if widgets == nil {
+		return nil, nil
+	}
+	widgetsBody := make([]map[string]any, 0, len(widgets))
+	for _, w := range widgets {
+		body, err := widgetToPortBody(w)
+		if err != nil {
+			return nil, err
+		}
+		widgetsBody = append(widgetsBody, body)
+	}
+	return &widgetsBody, nil
+}
+
+func widgetToPortBody(w WidgetModel) (map[string]any, error) {
+	switch {
+	case w.TableEntitiesExplorer != nil:
+		t := w.TableEntitiesExplorer
+		body := newWidgetBody("table-entities-explorer", t.ID, t.Title, t.Icon, t.Description)
+		setOptionalString(body, "blueprint", t.Blueprint)
+		return body, setWidgetDataset(body, t.Dataset)
+	case w.EntitiesPieChart != nil:
+		p := w.EntitiesPieChart
+		body := newWidgetBody("entities-pie-chart", p.ID, p.Title, p.Icon, p.Description)
+		body["blueprint"] = p.Blueprint.ValueString()
+		body["property"] = p.Property.ValueString()
+		return body, setWidgetDataset(body, p.Dataset)
+	case w.EntitiesNumberChart != nil:
+		n := w.EntitiesNumberChart
+		body := newWidgetBody("entities-number-chart", n.ID, n.Title, n.Icon, n.Description)
+		body["blueprint"] = n.Blueprint.ValueString()
+		body["calculationBy"] = n.CalculationBy.ValueString()
+		body["func"] = n.Func.ValueString()
+		setOptionalString(body, "property", n.Property)
+		setOptionalString(body, "averageOf", n.AverageOf)
+		setOptionalString(body, "unit", n.Unit)
+		setOptionalString(body, "unitCustom", n.UnitCustom)
+		return body, setWidgetDataset(body, n.Dataset)
+	case w.Markdown != nil:
+		m := w.Markdown
+		body := newWidgetBody("markdown", m.ID, m.Title, m.Icon, m.Description)
+		body["markdown"] = m.Markdown.ValueString()
+		return body, nil
+	case w.Iframe != nil:
+		i := w.Iframe
+		body := newWidgetBody("iframe-widget", i.ID, i.Title, i.Icon, i.Description)
+		body["url"] = i.Url.ValueString()
+		setOptionalString(body, "urlType", i.UrlType)
+		return body, nil
+	case w.ActionCard != nil:
+		a := w.ActionCard
+		body := newWidgetBody("action-card-widget", a.ID, a.Title, a.Icon, a.Description)
+		actions := make([]any, 0, len(a.Actions))
+		for _, action := range flex.TerraformStringListToGoArray(a.Actions) {
+			actions = append(actions, map[string]any{"action": action})
+		}
+		body["actions"] = actions
+		return body, nil
+	case w.Dashboard != nil:
+		return dashboardToPortBody(w.Dashboard)
+	}
+
+	v, err := utils.TerraformJsonStringToGoObject(w.Json.ValueStringPointer())
+	if err != nil {
+		return nil, err
+	}
+	return *v, nil
+}
+
+func dashboardToPortBody(d *DashboardWidgetModel) (map[string]any, error) {
+	layout := make([]any, 0, len(d.Layout))
+	for _, row := range d.Layout {
+		columns := make([]any, 0, len(row.Columns))
+		for _, column := range row.Columns {
+			columns = append(columns, map[string]any{
+				"id":   column.ID.ValueString(),
+				"size": column.Size.ValueInt64(),
+			})
+		}
+		layout = append(layout, map[string]any{
+			"height":  row.Height.ValueInt64(),
+			"columns": columns,
+		})
+	}
+
+	widgets := make([]any, 0, len(d.Widgets))
+	for _, child := range d.Widgets {
+		body, err := widgetToPortBody(child.widget())
+		if err != nil {
+			return nil, err
+		}
+		widgets = append(widgets, body)
+	}
+
+	return map[string]any{
+		"type":    "dashboard-widget",
+		"id":      d.ID.ValueString(),
+		"layout":  layout,
+		"widgets": widgets,
+	}, nil
+}
+
+// optionalString reads an optional string of a widget, treating the empty strings Port returns for unset fields as
+// null
+func optionalString(widget map[string]any, key string) types.String {
+	if s, ok := widget[key].(string); ok && s != "" {
+		return types.StringValue(s)
+	}
+	return types.StringNull()
+}
+
+func requiredString(widget map[string]any, key string) types.String {
+	s, _ := widget[key].(string)
+	return types.StringValue(s)
+}
+
+func int64Value(v any) types.Int64 {
+	if f, ok := v.(float64); ok {
+		return types.Int64Value(int64(f))
+	}
+	return types.Int64Null()
+}
+
+// writeTypedWidgetsToResource reads the widgets of the page into typed widgets. Widgets that were configured as JSON,
+// or whose type has no typed widget, are read as JSON.
+func writeTypedWidgetsToResource(widgets []map[string]any, prior []WidgetModel) ([]WidgetModel, error) {
+	typedWidgets := make([]WidgetModel, 0, len(widgets))
+	for i, widget := range widgets {
+		var priorWidget *WidgetModel
+		if i < len(prior) {
+			priorWidget = &prior[i]
+		}
+		w, err := writeWidgetToResource(widget, priorWidget, true)
+		if err != nil {
+			return nil, err
+		}
+		typedWidgets = append(typedWidgets, w)
+	}
+	return typedWidgets, nil
+}
+
+func writeWidgetToResource(widget map[string]any, prior *WidgetModel, allowDashboard bool) (WidgetModel, error) {
+	w := WidgetModel{Json: types.StringNull()}
+
+	priorJson := types.StringNull()
+	if prior != nil {
+		priorJson = prior.Json
+	}
+	if !priorJson.IsNull() {
+		j, err := refreshJSON(priorJson, widget)
+		w.Json = j
+		return w, err
+	}
+
+	priorDataset := types.StringNull()
+	if prior != nil {
+		switch {
+		case prior.TableEntitiesExplorer != nil:
+			priorDataset = prior.TableEntitiesExplorer.Dataset
+		case prior.EntitiesPieChart != nil:
+			priorDataset = prior.EntitiesPieChart.Dataset
+		case prior.EntitiesNumberChart != nil:
+			priorDataset = prior.EntitiesNumberChart.Dataset
+		}
+	}
+	dataset, err := refreshJSON(priorDataset, widget["dataset"])
+	if err != nil {
+		return w, err
+	}
+
+	id := requiredString(widget, "id")
+	title := optionalString(widget, "title")
+	icon := optionalString(widget, "icon")
+	description := optionalString(widget, "description")
+
+	switch widget["type"] {
+	case "table-entities-explorer":
+		w.TableEntitiesExplorer = &TableEntitiesExplorerWidgetModel{
+			ID:          id,
+			Title:       title,
+			Icon:        icon,
+			Description: description,
+			Blueprint:   optionalString(widget, "blueprint"),
+			Dataset:     dataset,
+		}
+	case "entities-pie-chart":
+		w.EntitiesPieChart = &EntitiesPieChartWidgetModel{
+			ID:          id,
+			Title:       title,
+			Icon:        icon,
+			Description: description,
+			Blueprint:   requiredString(widget, "blueprint"),
+			Property:    requiredString(widget, "property"),
+			Dataset:     dataset,
+		}
+	case "entities-number-chart":
+		w.EntitiesNumberChart = &EntitiesNumberChartWidgetModel{
+			ID:            id,
+			Title:         title,
+			Icon:          icon,
+			Description:   description,
+			Blueprint:     requiredString(widget, "blueprint"),
+			CalculationBy: requiredString(widget, "calculationBy"),
+			Func:          requiredString(widget, "func"),
+			Property:      optionalString(widget, "property"),
+			AverageOf:     optionalString(widget, "averageOf"),
+			Unit:          optionalString(widget, "unit"),
+			UnitCustom:    optionalString(widget, "unitCustom"),
+			Dataset:       dataset,
+		}
+	case "markdown":
+		w.Markdown = &MarkdownWidgetModel{
+			ID:          id,
+			Title:       title,
+			Icon:        icon,
+			Description: description,
+			Markdown:    requiredString(widget, "markdown"),
+		}
+	case "iframe-widget":
+		w.Iframe = &IframeWidgetModel{
+			ID:          id,
+			Title:       title,
+			Icon:        icon,
+			Description: description,
+			Url:         requiredString(widget, "url"),
+			UrlType:     optionalString(widget, "urlType"),
+		}
+	case "action-card-widget":
+		actions, _ := widget["actions"].([]any)
+		w.ActionCard = &ActionCardWidgetModel{
+			ID:          id,
+			Title:       title,
+			Icon:        icon,
+			Description: description,
+			Actions:     make([]types.String, 0, len(actions)),
+		}
+		for _, action := range actions {
+			if a, ok := action.(map[string]any); ok {
+				w.ActionCard.Actions = append(w.ActionCard.Actions, requiredString(a, "action"))
+			}
+		}
+	case "dashboard-widget":
+		if !allowDashboard {
+			return writeWidgetJSONToResource(widget)
+		}
+		var priorDashboard *DashboardWidgetModel
+		if prior != nil {
+			priorDashboard = prior.Dashboard
+		}
+		d, err := writeDashboardToResource(widget, priorDashboard)
+		if err != nil {
+			return w, err
+		}
+		w.Dashboard = d
+	default:
+		return writeWidgetJSONToResource(widget)
+	}
+
+	return w, nil
+}
+
+func writeWidgetJSONToResource(widget map[string]any) (WidgetModel, error) {
+	j, err := refreshJSON(types.StringNull(), widget)
+	return WidgetModel{Json: j}, err
+}
+
+func writeDashboardToResource(widget map[string]any, prior *DashboardWidgetModel) (*DashboardWidgetModel, error) {
+	d := &DashboardWidgetModel{
+		ID:      requiredString(widget, "id"),
+		Layout:  []DashboardRowModel{},
+		Widgets: []DashboardChildWidgetModel{},
+	}
+
+	layout, _ := widget["layout"].([]any)
+	for _, r := range layout {
+		row, ok := r.(map[string]any)
+		if !ok {
+			continue
+		}
+		rowModel := DashboardRowModel{
+			Height:  int64Value(row["height"]),
+			Columns: []DashboardColumnModel{},
+		}
+		columns, _ := row["columns"].([]any)
+		for _, c := range columns {
+			if column, ok := c.(map[string]any); ok {
+				rowModel.Columns = append(rowModel.Columns, DashboardColumnModel{
+					ID:   requiredString(column, "id"),
+					Size: int64Value(column["size"]),
+				})
+			}
+		}
+		d.Layout = append(d.Layout, rowModel)
+	}
+
+	widgets, _ := widget["widgets"].([]any)
+	for i, c := range widgets {
+		child, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		var priorChild *WidgetModel
+		if prior != nil && i < len(prior.Widgets) {
+			w := prior.Widgets[i].widget()
+			priorChild = &w
+		}
+		w, err := writeWidgetToResource(child, priorChild, false)
+		if err != nil {
+			return nil, err
+		}
+		d.Widgets = append(d.Widgets, w.dashboardChild())
+	}
+
+	return d, nil
+}
+
+// refreshJSON returns the value returned by Port as JSON, keeping the JSON in the state when it is semantically equal
+// to it, so formatting, key order and ids added by Port don't show as drift
+func refreshJSON(state types.String, remote any) (types.String, error) {
+	if remote == nil {
+		return types.StringNull(), nil
+	}
+
+	if !state.IsNull() && !state.IsUnknown() && semanticallyEqualJSON(state.ValueString(), remote) {
+		return state, nil
+	}
+
+	b, err := json.Marshal(remote)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	return types.StringValue(string(b)), nil
+}
+
+// semanticallyEqualJSON reports whether the configured JSON is equal to the value returned by Port, ignoring key
+// order and the ids Port adds to objects that were configured without one
+func semanticallyEqualJSON(configured string, remote any) bool {
+	var c any
+	if err := json.Unmarshal([]byte(configured), &c); err != nil {
+		return false
+	}
+
+	b, err := json.Marshal(remote)
+	if err != nil {
+		return false
+	}
+	var r any
+	if err := json.Unmarshal(b, &r); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(c, withoutAddedIds(r, c))
+}
+
+func withoutAddedIds(remote any, configured any) any {
+	switch r := remote.(type) {
+	case map[string]any:
+		c, ok := configured.(map[string]any)
+		if !ok {
+			return remote
+		}
+		result := make(map[string]any, len(r))
+		for key, value := range r {
+			if _, configured := c[key]; !configured && key == "id" {
+				continue
+			}
+			result[key] = withoutAddedIds(value, c[key])
+		}
+		return result
+	case []any:
+		c, ok := configured.([]any)
+		if !ok || len(c) != len(r) {
+			return remote
+		}
+		result := make([]any, len(r))
+		for i, value := range r {
+			result[i] = withoutAddedIds(value, c[i])
+		}
+		return result
+	}
+	return remote
+}
@@ -2,12 +2,17 @@ package search
 
 import (
 	"context"
+	"fmt"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 )
 
 var _ datasource.DataSource = &SearchDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &SearchDataSource{}
 
 func NewSearchDataSource() datasource.DataSource {
 	return &SearchDataSource{}
@@ -29,11 +34,43 @@ func (d *SearchDataSource) Metadata(ctx context.Context, req datasource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_search"
 }
 
+func (d *SearchDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data SearchDataModel
+	// values that are unknown at validation time (e.g. references to other resources) are checked by the API instead
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+
+	rules, diags := searchRulesFromList(ctx, data.Rules)
+	if diags.HasError() {
+		return
+	}
+	validateSearchRules(rules, path.Root("rules"), &resp.Diagnostics)
+}
+
 func (d *SearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data SearchDataModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
-	searchRequest, err := searchResourceToPortBody(&data)
+	rules, diags := searchRulesFromList(ctx, data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if blueprint := blueprintOfSearchRules(data.Combinator, rules); blueprint != "" {
+		b, _, err := d.portClient.ReadCachedBlueprint(ctx, blueprint)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+			return
+		}
+		validateSearchRulesAgainstBlueprint(rules, b, path.Root("rules"), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	searchRequest, err := searchResourceToPortBody(&data, rules)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to convert search data to port body", err.Error())
 		return
@@ -45,26 +82,89 @@ func (d *SearchDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	if data.Sort != nil {
+		sortEntities(searchResult.Entities, data.Sort)
+		if !data.Limit.IsNull() && int64(len(searchResult.Entities)) > data.Limit.ValueInt64() {
+			searchResult.Entities = searchResult.Entities[:data.Limit.ValueInt64()]
+		}
+	}
+
 	data.ID = types.StringValue(data.GenerateID())
 	data.MatchingBlueprints = goStringListToTFList(searchResult.MatchingBlueprints)
 
+	entities, err := searchResultToEntities(ctx, d.portClient, searchResult)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+	data.Entities = entities
+
+	data.Identifiers = make([]types.String, len(entities))
+	for i, e := range entities {
+		data.Identifiers[i] = e.Identifier
+	}
+
+	var duplicates []string
+	data.EntitiesByIdentifier, duplicates = entitiesByIdentifier(entities)
+	if len(duplicates) != 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("entities_by_identifier"), "duplicate entity identifiers", fmt.Sprintf("entities of different blueprints have the identifiers %s, they are left out of entities_by_identifier and are only available in entities", strings.Join(duplicates, ", ")))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// entitiesByIdentifier keys the entities by their identifier. Identifiers shared by entities of different blueprints
+// can't be keyed, so they are left out and returned as duplicates.
+func entitiesByIdentifier(entities []EntityModel) (map[string]EntitySummaryModel, []string) {
+	count := make(map[string]int, len(entities))
+	for _, e := range entities {
+		count[e.Identifier.ValueString()]++
+	}
+
+	var duplicates []string
+	result := make(map[string]EntitySummaryModel, len(entities))
+	for _, e := range entities {
+		identifier := e.Identifier.ValueString()
+		switch {
+		case count[identifier] > 1:
+			// the count is marked so every duplicate identifier is only reported once
+			duplicates = append(duplicates, identifier)
+			count[identifier] = -1
+			continue
+		case count[identifier] < 0:
+			continue
+		}
+		result[identifier] = EntitySummaryModel{
+			Identifier:     e.Identifier,
+			Blueprint:      e.Blueprint,
+			Title:          e.Title,
+			PropertiesJSON: e.PropertiesJSON,
+		}
+	}
+	return result, duplicates
+}
+
+func searchResultToEntities(ctx context.Context, portClient *cli.PortClient, searchResult *cli.SearchResult) ([]EntityModel, error) {
 	blueprints := make(map[string]cli.Blueprint)
 	for _, blueprint := range searchResult.MatchingBlueprints {
-		b, _, err := d.portClient.ReadBlueprint(ctx, blueprint)
+		b, _, err := portClient.ReadCachedBlueprint(ctx, blueprint)
 		if err != nil {
-			resp.Diagnostics.AddError("failed to read blueprint", err.Error())
-			return
+			return nil, err
 		}
 		blueprints[blueprint] = *b
 	}
 
+	var entities []EntityModel
 	for _, entity := range searchResult.Entities {
 		matchingEntityBlueprint := blueprints[entity.Blueprint]
-		e := refreshEntityState(ctx, &entity, &matchingEntityBlueprint)
-		data.Entities = append(data.Entities, *e)
+		e, err := refreshEntityState(ctx, &entity, &matchingEntityBlueprint)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, *e)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return entities, nil
 }
 
 func goStringListToTFList(list []string) []types.String {
@@ -2,9 +2,15 @@ package search
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -151,17 +157,132 @@ func EntitySchema() map[string]schema.Attribute {
 			MarkdownDescription: "The last updater of the entity",
 			Computed:            true,
 		},
+		"properties_json": schema.StringAttribute{
+			MarkdownDescription: "The properties of the entity as a JSON object keyed by the property identifier, use `jsondecode` to read properties of any type",
+			Computed:            true,
+		},
 	}
 }
 
+// searchRuleGroupDepth is how deep groups of search rules can be nested. The schema can't be recursive, so every level
+// of nesting is a copy of the rule attributes.
+const searchRuleGroupDepth = 3
+
+// SearchRuleSchema returns the attributes of a search rule. Up to depth levels deep, a rule can also be a group of
+// rules with its own combinator.
+func SearchRuleSchema(depth int) map[string]schema.Attribute {
+	rule := map[string]schema.Attribute{
+		"property": schema.StringAttribute{
+			MarkdownDescription: "The property identifier, or meta property (e.g. `$identifier`, `$title`, `$blueprint`, `$team`, `$createdAt`) the rule applies to",
+			Optional:            true,
+		},
+		"relation": schema.StringAttribute{
+			MarkdownDescription: "The relation identifier the rule applies to",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("property")),
+			},
+		},
+		"operator": schema.StringAttribute{
+			MarkdownDescription: "The operator of the rule",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(searchOperators...),
+			},
+		},
+		"value": schema.StringAttribute{
+			MarkdownDescription: "The value to compare to",
+			Optional:            true,
+		},
+		"number_value": schema.Float64Attribute{
+			MarkdownDescription: "The number value to compare to",
+			Optional:            true,
+		},
+		"bool_value": schema.BoolAttribute{
+			MarkdownDescription: "The boolean value to compare to",
+			Optional:            true,
+		},
+		"values": schema.ListAttribute{
+			MarkdownDescription: "The values to compare to, for the `in`, `notIn` and `containsAny` operators",
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"from": schema.StringAttribute{
+			MarkdownDescription: "The start date of the range, for the `between` and `notBetween` operators",
+			Optional:            true,
+		},
+		"to": schema.StringAttribute{
+			MarkdownDescription: "The end date of the range, for the `between` and `notBetween` operators",
+			Optional:            true,
+		},
+		"preset": schema.StringAttribute{
+			MarkdownDescription: "The preset date range (e.g. `lastWeek`), for the `between` and `notBetween` operators",
+			Optional:            true,
+		},
+		"blueprint": schema.StringAttribute{
+			MarkdownDescription: "The blueprint of the entity, for the `relatedTo` operator",
+			Optional:            true,
+		},
+		"direction": schema.StringAttribute{
+			MarkdownDescription: "The direction of the relation, `upstream` or `downstream`, for the `relatedTo` operator",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("upstream", "downstream"),
+			},
+		},
+		"required": schema.BoolAttribute{
+			MarkdownDescription: "Whether only required relations are followed, for the `relatedTo` operator",
+			Optional:            true,
+		},
+	}
+	if depth == 0 {
+		return rule
+	}
+
+	rule["combinator"] = schema.StringAttribute{
+		MarkdownDescription: "The combinator of a nested group of rules, `and` or `or`",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("and", "or"),
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("rules")),
+		},
+	}
+	rule["rules"] = schema.ListNestedAttribute{
+		MarkdownDescription: fmt.Sprintf("The rules of a nested group of rules. Groups can be nested %d levels deep", searchRuleGroupDepth),
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: SearchRuleSchema(depth - 1),
+		},
+	}
+	return rule
+}
+
 func Schema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed: true,
 		},
 		"query": schema.StringAttribute{
-			MarkdownDescription: "The search query",
-			Required:            true,
+			MarkdownDescription: "The search query as a JSON string",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.ExactlyOneOf(path.MatchRoot("rules")),
+			},
+		},
+		"combinator": schema.StringAttribute{
+			MarkdownDescription: "The combinator of the `rules`, `and` or `or`. Defaults to `and`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("and", "or"),
+				stringvalidator.AlsoRequires(path.MatchRoot("rules")),
+			},
+		},
+		"rules": schema.ListNestedAttribute{
+			MarkdownDescription: "The rules of the search query, an alternative to `query`",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: SearchRuleSchema(searchRuleGroupDepth),
+			},
 		},
 		"exclude_calculated_properties": schema.BoolAttribute{
 			MarkdownDescription: "Exclude calculated properties",
@@ -181,6 +302,30 @@ func Schema() map[string]schema.Attribute {
 			MarkdownDescription: "Attach title to relation",
 			Optional:            true,
 		},
+		"limit": schema.Int64Attribute{
+			MarkdownDescription: "The maximum number of entities to return. The limit is sent to the Port API, unless `sort` is set",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"sort": schema.SingleNestedAttribute{
+			MarkdownDescription: "The order of the returned entities. The entities are sorted by the provider, so setting `sort` disables the limit of the Port API: all the matching entities are fetched and sorted before `limit` is applied. Narrow down the search with the query or rules when sorting large blueprints",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"property": schema.StringAttribute{
+					MarkdownDescription: "The property identifier, or meta property (`$identifier`, `$title`, `$createdAt`, `$updatedAt`) to sort by",
+					Required:            true,
+				},
+				"order": schema.StringAttribute{
+					MarkdownDescription: "The sort order, `asc` or `desc`. Defaults to `asc`",
+					Optional:            true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("asc", "desc"),
+					},
+				},
+			},
+		},
 		"matching_blueprints": schema.ListAttribute{
 			MarkdownDescription: "The matching blueprints for the search query",
 			Computed:            true,
@@ -193,6 +338,35 @@ func Schema() map[string]schema.Attribute {
 				Attributes: EntitySchema(),
 			},
 		},
+		"entities_by_identifier": schema.MapNestedAttribute{
+			MarkdownDescription: "The entities matching the search query keyed by their identifier, for use in `for_each`. Only the identifier, blueprint, title and properties of the entities are kept, the complete entities are in `entities`. Entities of different blueprints that have the same identifier are left out, with a warning",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"identifier": schema.StringAttribute{
+						MarkdownDescription: "The identifier of the entity",
+						Computed:            true,
+					},
+					"blueprint": schema.StringAttribute{
+						MarkdownDescription: "The blueprint identifier the entity relates to",
+						Computed:            true,
+					},
+					"title": schema.StringAttribute{
+						MarkdownDescription: "The title of the entity",
+						Computed:            true,
+					},
+					"properties_json": schema.StringAttribute{
+						MarkdownDescription: "The properties of the entity as a JSON object keyed by the property identifier, use `jsondecode` to read properties of any type",
+						Computed:            true,
+					},
+				},
+			},
+		},
+		"identifiers": schema.ListAttribute{
+			MarkdownDescription: "The identifiers of the entities matching the search query, in the order of `entities`",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
 	}
 }
 
@@ -243,6 +417,55 @@ data "port_search" "ads_service" {
 
 ` + "\n```" + `
 
+### Search with typed rules instead of a JSON query:
+
+` + "```hcl" + `
+
+data "port_search" "production_services" {
+  combinator = "and"
+  rules = [
+    { property = "$blueprint", operator = "=", value = "Service" },
+    { property = "environment", operator = "in", values = ["production", "staging"] },
+    { property = "$createdAt", operator = "between", preset = "lastWeek" },
+    {
+      combinator = "or"
+      rules = [
+        { property = "language", operator = "=", value = "Go" },
+        { relation = "domain", operator = "=", value = "payments" },
+      ]
+    },
+  ]
+}
+
+` + "\n```" + `
+
+Groups of rules can contain groups themselves, up to three levels deep. Use ` + "`query`" + ` for queries with deeper groups.
+
+The rules are validated before the search is performed, and when the rules are scoped to a blueprint with a top level ` + "`$blueprint`" + ` rule, the operators are validated against the types of the blueprint properties.
+
+Iterate over the results with ` + "`for_each`" + `, using ` + "`properties_json`" + ` to read the properties of the entities:
+
+` + "```hcl" + `
+data "port_search" "all_services" {
+  query = jsonencode({
+    "combinator" : "and", "rules" : [
+      { "operator" : "=", "property" : "$blueprint", "value" : "Service" },
+    ]
+  })
+}
+
+resource "port_entity" "deployment" {
+  for_each  = data.port_search.all_services.entities_by_identifier
+  title     = "${each.value.title} deployment"
+  blueprint = "Deployment"
+  properties = {
+    string_props = {
+      "language" = jsondecode(each.value.properties_json)["language"]
+    }
+  }
+}
+` + "\n```" + `
+
 ### Scorecards automation example
 In this example we are creating a jira task for each service that its Ownership Scorecard hasn't reached Gold level : 
 
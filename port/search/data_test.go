@@ -3,6 +3,7 @@ package search_test
 import (
 	"fmt"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -735,3 +736,352 @@ func TestAccPortEntityUpdateProp(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPortRelatedEntities(t *testing.T) {
+	identifier := utils.GenID()
+	identifier2 := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {}
+		relations = {
+			"tfRelation" = {
+				"title" = "Test Relation"
+				"target" = port_blueprint.microservice2.identifier
+			}
+		}
+	}
+	resource "port_blueprint" "microservice2" {
+		title = "TF Provider Test BP1"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {}
+	}
+
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		relations = {
+			single_relations = {
+				"tfRelation" = port_entity.microservice2.identifier
+			}
+		}
+	}
+
+	resource "port_entity" "microservice2" {
+		title = "TF Provider Test Entity1"
+		blueprint = port_blueprint.microservice2.identifier
+	}
+	`, identifier, identifier2)
+
+	var testRelatedEntities = `
+	data "port_related_entities" "upstream" {
+		entity_identifier = port_entity.microservice.identifier
+		entity_blueprint  = port_entity.microservice.blueprint
+		direction         = "upstream"
+	}
+
+	data "port_related_entities" "downstream" {
+		entity_identifier = port_entity.microservice2.identifier
+		entity_blueprint  = port_entity.microservice2.blueprint
+		direction         = "downstream"
+		blueprint         = port_blueprint.microservice.identifier
+		depth             = 1
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate + testRelatedEntities,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_related_entities.upstream", "entities.#", "1"),
+					resource.TestCheckResourceAttr("data.port_related_entities.upstream", "entities.0.title", "TF Provider Test Entity1"),
+					resource.TestCheckResourceAttr("data.port_related_entities.upstream", "entities.0.blueprint", identifier2),
+					resource.TestCheckResourceAttr("data.port_related_entities.downstream", "entities.#", "1"),
+					resource.TestCheckResourceAttr("data.port_related_entities.downstream", "entities.0.title", "TF Provider Test Entity0"),
+					resource.TestCheckResourceAttr("data.port_related_entities.downstream", "entities.0.blueprint", identifier),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortSearchRules(t *testing.T) {
+	identifier := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  {
+					"title" = "My String Identifier"
+				}
+			}
+			"number_props" = {
+				"myNumberIdentifier" =  {
+					"title" = "My Number Identifier"
+				}
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  "My String Value"
+			}
+			"number_props" = {
+				"myNumberIdentifier" =  5
+			}
+		}
+	}`, identifier)
+
+	var testSearchRules = fmt.Sprintf(`
+	data "port_search" "microservice" {
+		rules = [
+			{ property = "$blueprint", operator = "=", value = "%s" },
+			{ property = "$identifier", operator = "=", value = port_entity.microservice.identifier },
+			{
+				combinator = "or"
+				rules = [
+					{ property = "myStringIdentifier", operator = "contains", value = "String" },
+					{
+						combinator = "and"
+						rules = [
+							{ property = "myNumberIdentifier", operator = ">", number_value = 10 },
+							{ property = "$title", operator = "beginsWith", value = "TF" },
+						]
+					},
+				]
+			},
+		]
+	}`, identifier)
+
+	var testSearchInvalidOperator = fmt.Sprintf(`
+	data "port_search" "microservice" {
+		rules = [
+			{ property = "$blueprint", operator = "=", value = "%s" },
+			{
+				rules = [
+					{ property = "$title", operator = "beginsWith", value = "TF" },
+					{
+						rules = [
+							{ property = "myNumberIdentifier", operator = "contains", value = "5" },
+						]
+					},
+				]
+			},
+		]
+	}`, identifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate + testSearchRules,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities.#", "1"),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities.0.title", "TF Provider Test Entity0"),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities.0.blueprint", identifier),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities.0.properties_json", `{"myNumberIdentifier":5,"myStringIdentifier":"My String Value"}`),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.port_search.microservice", "identifiers.0", "port_entity.microservice", "identifier"),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities_by_identifier.%", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.port_search.microservice", "entities_by_identifier.*.identifier", "port_entity.microservice", "identifier"),
+					resource.TestCheckTypeSetElemAttr("data.port_search.microservice", "entities_by_identifier.*.properties_json", `{"myNumberIdentifier":5,"myStringIdentifier":"My String Value"}`),
+				),
+			},
+			{
+				Config:      acctest.ProviderConfig + testAccActionConfigCreate + testSearchInvalidOperator,
+				ExpectError: regexp.MustCompile("invalid operator"),
+			},
+		},
+	})
+}
+
+func TestAccPortSearchDuplicateIdentifiers(t *testing.T) {
+	identifier := utils.GenID()
+	otherIdentifier := utils.GenID()
+	entityIdentifier := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+	}
+	resource "port_blueprint" "microservice2" {
+		title = "TF Provider Test BP1"
+		icon = "Terraform"
+		identifier = "%s"
+	}
+	resource "port_entity" "microservice" {
+		title = "TF Provider Test Entity0"
+		blueprint = port_blueprint.microservice.identifier
+		identifier = "%s"
+	}
+	resource "port_entity" "microservice2" {
+		title = "TF Provider Test Entity1"
+		blueprint = port_blueprint.microservice2.identifier
+		identifier = "%s"
+	}`, identifier, otherIdentifier, entityIdentifier, entityIdentifier)
+
+	var testSearchBothBlueprints = `
+	data "port_search" "microservice" {
+		combinator = "or"
+		rules = [
+			{ property = "$blueprint", operator = "=", value = port_entity.microservice.blueprint },
+			{ property = "$blueprint", operator = "=", value = port_entity.microservice2.blueprint },
+		]
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate,
+			},
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate + testSearchBothBlueprints,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities.#", "2"),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "identifiers.#", "2"),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities_by_identifier.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortSearchLimitAndSort(t *testing.T) {
+	identifier := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"number_props" = {
+				"myNumberIdentifier" =  {
+					"title" = "My Number Identifier"
+				}
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		count = 3
+		title = "TF Provider Test Entity${count.index}"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			"number_props" = {
+				"myNumberIdentifier" =  count.index
+			}
+		}
+	}`, identifier)
+
+	var testSearchLimitAndSort = fmt.Sprintf(`
+	data "port_search" "microservice" {
+		depends_on = [port_entity.microservice]
+		query = jsonencode({
+			"combinator" : "and", "rules" : [
+				{ "operator" : "=", "property" : "$blueprint", "value" : "%s" },
+			]
+		})
+		limit = 2
+		sort = {
+			property = "myNumberIdentifier"
+			order = "desc"
+		}
+	}
+	data "port_search" "limited" {
+		depends_on = [port_entity.microservice]
+		query = jsonencode({
+			"combinator" : "and", "rules" : [
+				{ "operator" : "=", "property" : "$blueprint", "value" : "%s" },
+			]
+		})
+		limit = 1
+	}`, identifier, identifier)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate + testSearchLimitAndSort,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities.#", "2"),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities.0.title", "TF Provider Test Entity2"),
+					resource.TestCheckResourceAttr("data.port_search.microservice", "entities.1.title", "TF Provider Test Entity1"),
+					resource.TestCheckResourceAttr("data.port_search.limited", "entities.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPortEntitiesImport(t *testing.T) {
+	identifier := utils.GenID()
+	var testAccActionConfigCreate = fmt.Sprintf(`
+	resource "port_blueprint" "microservice" {
+		title = "TF Provider Test BP0"
+		icon = "Terraform"
+		identifier = "%s"
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  {
+					"title" = "My String Identifier"
+				}
+			}
+		}
+	}
+	resource "port_entity" "microservice" {
+		count = 2
+		identifier = "entity-${count.index}"
+		title = "TF Provider Test Entity${count.index}"
+		blueprint = port_blueprint.microservice.identifier
+		properties = {
+			"string_props" = {
+				"myStringIdentifier" =  "My String Value ${count.index}"
+			}
+		}
+	}`, identifier)
+
+	var testEntitiesImport = `
+	data "port_entities_import" "microservice" {
+		depends_on = [port_entity.microservice]
+		blueprint = port_blueprint.microservice.identifier
+		rules = [
+			{ property = "myStringIdentifier", operator = "=", value = "My String Value 1" },
+		]
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccActionConfigCreate + testEntitiesImport,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.port_entities_import.microservice", "resource_names.%", "1"),
+					resource.TestCheckResourceAttr("data.port_entities_import.microservice", "resource_names.entity-1", "entity-1"),
+					resource.TestCheckResourceAttr("data.port_entities_import.microservice", "import_blocks", fmt.Sprintf("import {\n  to = port_entity.entity-1\n  id = \"%s:entity-1\"\n}\n", identifier)),
+					resource.TestMatchResourceAttr("data.port_entities_import.microservice", "generated_config", regexp.MustCompile(`myStringIdentifier = "My String Value 1"`)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,231 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var invalidResourceNameCharactersRegex = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// entitiesImportResourceNames maps the identifier of every entity to a unique resource name derived from it
+func entitiesImportResourceNames(entities []cli.Entity) map[string]string {
+	names := make(map[string]string, len(entities))
+	used := make(map[string]bool, len(entities))
+	for _, e := range entities {
+		name := invalidResourceNameCharactersRegex.ReplaceAllString(e.Identifier, "_")
+		// resource names must start with a letter or an underscore
+		if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+			name = "_" + name
+		}
+
+		unique := name
+		for i := 2; used[unique]; i++ {
+			unique = fmt.Sprintf("%s_%d", name, i)
+		}
+		used[unique] = true
+		names[e.Identifier] = unique
+	}
+	return names
+}
+
+func entitiesImportBlocks(entities []cli.Entity, names map[string]string) string {
+	f := hclwrite.NewEmptyFile()
+	for i, e := range entities {
+		if i != 0 {
+			f.Body().AppendNewline()
+		}
+		block := f.Body().AppendNewBlock("import", nil).Body()
+		block.SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: "port_entity"},
+			hcl.TraverseAttr{Name: names[e.Identifier]},
+		})
+		block.SetAttributeValue("id", cty.StringVal(fmt.Sprintf("%s:%s", e.Blueprint, e.Identifier)))
+	}
+	return string(f.Bytes())
+}
+
+func entitiesGeneratedConfig(entities []cli.Entity, names map[string]string, b *cli.Blueprint) (string, error) {
+	f := hclwrite.NewEmptyFile()
+	for i, e := range entities {
+		if i != 0 {
+			f.Body().AppendNewline()
+		}
+		block := f.Body().AppendNewBlock("resource", []string{"port_entity", names[e.Identifier]}).Body()
+		block.SetAttributeValue("identifier", cty.StringVal(e.Identifier))
+		block.SetAttributeValue("title", cty.StringVal(e.Title))
+		block.SetAttributeValue("blueprint", cty.StringVal(e.Blueprint))
+
+		if len(e.Team) != 0 {
+			teams := make([]cty.Value, len(e.Team))
+			for j, t := range e.Team {
+				teams[j] = cty.StringVal(t)
+			}
+			block.SetAttributeValue("teams", cty.ListVal(teams))
+		}
+
+		properties, err := entityPropertiesToCty(e, b)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate the properties of entity %s: %s", e.Identifier, err.Error())
+		}
+		if !properties.IsNull() {
+			block.SetAttributeValue("properties", properties)
+		}
+
+		if relations := entityRelationsToCty(e, b); !relations.IsNull() {
+			block.SetAttributeValue("relations", relations)
+		}
+	}
+	return string(f.Bytes()), nil
+}
+
+// entityPropertiesToCty converts the properties of the entity to the value of the properties attribute of port_entity,
+// properties that are not part of the blueprint schema (e.g. calculation and mirror properties) are skipped. A null
+// value is returned when the entity has no properties to set.
+func entityPropertiesToCty(e cli.Entity, b *cli.Blueprint) (cty.Value, error) {
+	props := map[string]map[string]cty.Value{}
+	arrayProps := map[string]map[string]cty.Value{}
+	add := func(m map[string]map[string]cty.Value, kind string, identifier string, v cty.Value) {
+		if m[kind] == nil {
+			m[kind] = map[string]cty.Value{}
+		}
+		m[kind][identifier] = v
+	}
+
+	for identifier, value := range e.Properties {
+		bp, ok := b.Schema.Properties[identifier]
+		if !ok || value == nil {
+			continue
+		}
+
+		switch bp.Type {
+		case "string":
+			if s, ok := value.(string); ok {
+				add(props, "string_props", identifier, cty.StringVal(s))
+			}
+		case "number":
+			if n, ok := value.(float64); ok {
+				add(props, "number_props", identifier, cty.NumberFloatVal(n))
+			}
+		case "boolean":
+			if v, ok := value.(bool); ok {
+				add(props, "boolean_props", identifier, cty.BoolVal(v))
+			}
+		case "object":
+			js, err := json.Marshal(value)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			add(props, "object_props", identifier, cty.StringVal(string(js)))
+		case "array":
+			items, ok := value.([]interface{})
+			if !ok {
+				continue
+			}
+			itemsType, ok := bp.Items["type"].(string)
+			if !ok {
+				itemsType = "string"
+			}
+			list, err := arrayItemsToCty(items, itemsType)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			add(arrayProps, itemsType+"_items", identifier, list)
+		}
+	}
+
+	attributes := map[string]cty.Value{}
+	for kind, values := range props {
+		attributes[kind] = cty.ObjectVal(values)
+	}
+	if len(arrayProps) != 0 {
+		arrayAttributes := map[string]cty.Value{}
+		for kind, values := range arrayProps {
+			arrayAttributes[kind] = cty.ObjectVal(values)
+		}
+		attributes["array_props"] = cty.ObjectVal(arrayAttributes)
+	}
+
+	if len(attributes) == 0 {
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	}
+	return cty.ObjectVal(attributes), nil
+}
+
+func arrayItemsToCty(items []interface{}, itemsType string) (cty.Value, error) {
+	elementType := cty.String
+	switch itemsType {
+	case "number":
+		elementType = cty.Number
+	case "boolean":
+		elementType = cty.Bool
+	}
+	if len(items) == 0 {
+		return cty.ListValEmpty(elementType), nil
+	}
+
+	values := make([]cty.Value, len(items))
+	for i, item := range items {
+		switch v := item.(type) {
+		case string:
+			values[i] = cty.StringVal(v)
+		case float64:
+			values[i] = cty.NumberFloatVal(v)
+		case bool:
+			values[i] = cty.BoolVal(v)
+		default:
+			js, err := json.Marshal(v)
+			if err != nil {
+				return cty.NilVal, err
+			}
+			values[i] = cty.StringVal(string(js))
+		}
+		if values[i].Type() != elementType {
+			return cty.NilVal, fmt.Errorf("array item %v is not of type %s", item, itemsType)
+		}
+	}
+	return cty.ListVal(values), nil
+}
+
+func entityRelationsToCty(e cli.Entity, b *cli.Blueprint) cty.Value {
+	single := map[string]cty.Value{}
+	many := map[string]cty.Value{}
+
+	for identifier, relation := range e.Relations {
+		if _, ok := b.Relations[identifier]; !ok {
+			continue
+		}
+
+		switch v := relation.(type) {
+		case string:
+			single[identifier] = cty.StringVal(v)
+		case []interface{}:
+			values := make([]cty.Value, 0, len(v))
+			for _, item := range v {
+				if s, ok := item.(string); ok {
+					values = append(values, cty.StringVal(s))
+				}
+			}
+			if len(values) != 0 {
+				many[identifier] = cty.ListVal(values)
+			}
+		}
+	}
+
+	attributes := map[string]cty.Value{}
+	if len(single) != 0 {
+		attributes["single_relations"] = cty.ObjectVal(single)
+	}
+	if len(many) != 0 {
+		attributes["many_relations"] = cty.ObjectVal(many)
+	}
+	if len(attributes) == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return cty.ObjectVal(attributes)
+}
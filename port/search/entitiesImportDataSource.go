@@ -0,0 +1,102 @@
+package search
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ datasource.DataSource = &EntitiesImportDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &EntitiesImportDataSource{}
+
+func NewEntitiesImportDataSource() datasource.DataSource {
+	return &EntitiesImportDataSource{}
+}
+
+type EntitiesImportDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *EntitiesImportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *EntitiesImportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entities_import"
+}
+
+func (d *EntitiesImportDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data EntitiesImportDataModel
+	// values that are unknown at validation time (e.g. references to other resources) are checked by the API instead
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+
+	rules, diags := searchRulesFromList(ctx, data.Rules)
+	if diags.HasError() {
+		return
+	}
+	validateSearchRules(rules, path.Root("rules"), &resp.Diagnostics)
+}
+
+func (d *EntitiesImportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EntitiesImportDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := searchRulesFromList(ctx, data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, _, err := d.portClient.ReadCachedBlueprint(ctx, data.Blueprint.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+
+	validateSearchRulesAgainstBlueprint(rules, b, path.Root("rules"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searchResult, err := d.portClient.Search(ctx, entitiesImportToPortBody(&data, rules))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to search", err.Error())
+		return
+	}
+
+	entities := searchResult.Entities
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Identifier < entities[j].Identifier
+	})
+
+	names := entitiesImportResourceNames(entities)
+	generatedConfig, err := entitiesGeneratedConfig(entities, names, b)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to generate the entities configuration", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(data.Blueprint.ValueString())
+	data.ImportBlocks = types.StringValue(entitiesImportBlocks(entities, names))
+	data.GeneratedConfig = types.StringValue(generatedConfig)
+	data.ResourceNames = make(map[string]types.String, len(names))
+	for identifier, name := range names {
+		data.ResourceNames[identifier] = types.StringValue(name)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,93 @@
+package search
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func EntitiesImportSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"blueprint": schema.StringAttribute{
+			MarkdownDescription: "The blueprint of the entities to import",
+			Required:            true,
+		},
+		"combinator": schema.StringAttribute{
+			MarkdownDescription: "The combinator of the `rules`, `and` or `or`. Defaults to `and`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("and", "or"),
+				stringvalidator.AlsoRequires(path.MatchRoot("rules")),
+			},
+		},
+		"rules": schema.ListNestedAttribute{
+			MarkdownDescription: "The search rules the entities to import must match, all the entities of the blueprint are imported when not set",
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: SearchRuleSchema(searchRuleGroupDepth),
+			},
+		},
+		"resource_names": schema.MapAttribute{
+			MarkdownDescription: "The name of the generated `port_entity` resource of every entity, keyed by the entity identifier",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"import_blocks": schema.StringAttribute{
+			MarkdownDescription: "The `import` blocks of the matching entities",
+			Computed:            true,
+		},
+		"generated_config": schema.StringAttribute{
+			MarkdownDescription: "The `port_entity` resources of the matching entities",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *EntitiesImportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: EntitiesImportDataSourceMarkdownDescription,
+		Attributes:          EntitiesImportSchema(),
+	}
+}
+
+var EntitiesImportDataSourceMarkdownDescription = `
+
+# Entities Import Data Source
+
+The entities import data source generates the ` + "`import`" + ` blocks and the ` + "`port_entity`" + ` configuration of existing entities, so many entities can be brought under Terraform at once.
+
+## Example Usage
+
+### Import all the production services:
+
+` + "```hcl" + `
+
+data "port_entities_import" "production_services" {
+  blueprint = "Service"
+  rules = [
+    { property = "environment", operator = "=", value = "production" },
+  ]
+}
+
+output "production_services_import" {
+  value = "${data.port_entities_import.production_services.import_blocks}\n${data.port_entities_import.production_services.generated_config}"
+}
+
+` + "```" + `
+
+Write the output to a file of the module and run ` + "`terraform plan`" + ` to review the imports:
+
+` + "```shell" + `
+terraform output -raw production_services_import > production_services.tf
+` + "```" + `
+
+Remove the data source and the output once the entities are imported.
+`
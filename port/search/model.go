@@ -40,36 +40,88 @@ type ScorecardModel struct {
 }
 
 type EntityModel struct {
-	Identifier types.String               `tfsdk:"identifier"`
-	Blueprint  types.String               `tfsdk:"blueprint"`
-	Title      types.String               `tfsdk:"title"`
-	Icon       types.String               `tfsdk:"icon"`
-	RunID      types.String               `tfsdk:"run_id"`
-	CreatedAt  types.String               `tfsdk:"created_at"`
-	CreatedBy  types.String               `tfsdk:"created_by"`
-	UpdatedAt  types.String               `tfsdk:"updated_at"`
-	UpdatedBy  types.String               `tfsdk:"updated_by"`
-	Properties *EntityPropertiesModel     `tfsdk:"properties"`
-	Teams      []types.String             `tfsdk:"teams"`
-	Scorecards *map[string]ScorecardModel `tfsdk:"scorecards"`
-	Relations  *RelationModel             `tfsdk:"relations"`
+	Identifier     types.String               `tfsdk:"identifier"`
+	Blueprint      types.String               `tfsdk:"blueprint"`
+	Title          types.String               `tfsdk:"title"`
+	Icon           types.String               `tfsdk:"icon"`
+	RunID          types.String               `tfsdk:"run_id"`
+	CreatedAt      types.String               `tfsdk:"created_at"`
+	CreatedBy      types.String               `tfsdk:"created_by"`
+	UpdatedAt      types.String               `tfsdk:"updated_at"`
+	UpdatedBy      types.String               `tfsdk:"updated_by"`
+	Properties     *EntityPropertiesModel     `tfsdk:"properties"`
+	PropertiesJSON types.String               `tfsdk:"properties_json"`
+	Teams          []types.String             `tfsdk:"teams"`
+	Scorecards     *map[string]ScorecardModel `tfsdk:"scorecards"`
+	Relations      *RelationModel             `tfsdk:"relations"`
+}
+
+type EntitiesImportDataModel struct {
+	ID              types.String            `tfsdk:"id"`
+	Blueprint       types.String            `tfsdk:"blueprint"`
+	Combinator      types.String            `tfsdk:"combinator"`
+	Rules           types.List              `tfsdk:"rules"`
+	ResourceNames   map[string]types.String `tfsdk:"resource_names"`
+	ImportBlocks    types.String            `tfsdk:"import_blocks"`
+	GeneratedConfig types.String            `tfsdk:"generated_config"`
+}
+
+// EntitySummaryModel is the entry of an entity in entities_by_identifier, the complete entity is in entities
+type EntitySummaryModel struct {
+	Identifier     types.String `tfsdk:"identifier"`
+	Blueprint      types.String `tfsdk:"blueprint"`
+	Title          types.String `tfsdk:"title"`
+	PropertiesJSON types.String `tfsdk:"properties_json"`
+}
+
+// SearchRuleModel is a search rule, or a group of rules when Rules is set. Groups are read by searchRulesFromList,
+// as the rules at the deepest level of nesting don't have the combinator and rules attributes.
+type SearchRuleModel struct {
+	Property    types.String      `tfsdk:"property"`
+	Relation    types.String      `tfsdk:"relation"`
+	Operator    types.String      `tfsdk:"operator"`
+	Value       types.String      `tfsdk:"value"`
+	NumberValue types.Float64     `tfsdk:"number_value"`
+	BoolValue   types.Bool        `tfsdk:"bool_value"`
+	Values      []types.String    `tfsdk:"values"`
+	From        types.String      `tfsdk:"from"`
+	To          types.String      `tfsdk:"to"`
+	Preset      types.String      `tfsdk:"preset"`
+	Blueprint   types.String      `tfsdk:"blueprint"`
+	Direction   types.String      `tfsdk:"direction"`
+	Required    types.Bool        `tfsdk:"required"`
+	Combinator  types.String      `tfsdk:"-"`
+	Rules       []SearchRuleModel `tfsdk:"-"`
+}
+
+type SearchSortModel struct {
+	Property types.String `tfsdk:"property"`
+	Order    types.String `tfsdk:"order"`
 }
 
 type SearchDataModel struct {
-	ID                          types.String   `tfsdk:"id"`
-	Query                       types.String   `tfsdk:"query"`
-	ExcludeCalculatedProperties types.Bool     `tfsdk:"exclude_calculated_properties"`
-	Include                     []types.String `tfsdk:"include"`
-	Exclude                     []types.String `tfsdk:"exclude"`
-	AttachTitleToRelation       types.Bool     `tfsdk:"attach_title_to_relation"`
-	MatchingBlueprints          []types.String `tfsdk:"matching_blueprints"`
-	Entities                    []EntityModel  `tfsdk:"entities"`
+	ID                          types.String                  `tfsdk:"id"`
+	Query                       types.String                  `tfsdk:"query"`
+	Combinator                  types.String                  `tfsdk:"combinator"`
+	Rules                       types.List                    `tfsdk:"rules"`
+	ExcludeCalculatedProperties types.Bool                    `tfsdk:"exclude_calculated_properties"`
+	Include                     []types.String                `tfsdk:"include"`
+	Exclude                     []types.String                `tfsdk:"exclude"`
+	AttachTitleToRelation       types.Bool                    `tfsdk:"attach_title_to_relation"`
+	Limit                       types.Int64                   `tfsdk:"limit"`
+	Sort                        *SearchSortModel              `tfsdk:"sort"`
+	MatchingBlueprints          []types.String                `tfsdk:"matching_blueprints"`
+	Entities                    []EntityModel                 `tfsdk:"entities"`
+	EntitiesByIdentifier        map[string]EntitySummaryModel `tfsdk:"entities_by_identifier"`
+	Identifiers                 []types.String                `tfsdk:"identifiers"`
 }
 
 func (m *SearchDataModel) GenerateID() string {
 	// Concatenate the model fields into a single string
 	var sb strings.Builder
 	sb.WriteString(m.Query.ValueString())
+	sb.WriteString(m.Combinator.ValueString())
+	sb.WriteString(fmt.Sprintf("%v", m.Rules))
 	sb.WriteString(fmt.Sprintf("%t", m.ExcludeCalculatedProperties.ValueBool()))
 	for _, include := range m.Include {
 		sb.WriteString(include.ValueString())
@@ -78,6 +130,11 @@ func (m *SearchDataModel) GenerateID() string {
 		sb.WriteString(exclude.ValueString())
 	}
 	sb.WriteString(fmt.Sprintf("%t", m.AttachTitleToRelation.ValueBool()))
+	sb.WriteString(fmt.Sprintf("%d", m.Limit.ValueInt64()))
+	if m.Sort != nil {
+		sb.WriteString(m.Sort.Property.ValueString())
+		sb.WriteString(m.Sort.Order.ValueString())
+	}
 
 	// Compute the SHA-256 hash of the concatenated string
 	hash := sha256.Sum256([]byte(sb.String()))
@@ -87,3 +144,14 @@ func (m *SearchDataModel) GenerateID() string {
 
 	return hashString
 }
+
+type RelatedEntitiesDataModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	EntityIdentifier   types.String   `tfsdk:"entity_identifier"`
+	EntityBlueprint    types.String   `tfsdk:"entity_blueprint"`
+	Direction          types.String   `tfsdk:"direction"`
+	Blueprint          types.String   `tfsdk:"blueprint"`
+	Depth              types.Int64    `tfsdk:"depth"`
+	MatchingBlueprints []types.String `tfsdk:"matching_blueprints"`
+	Entities           []EntityModel  `tfsdk:"entities"`
+}
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+var _ datasource.DataSource = &RelatedEntitiesDataSource{}
+
+func NewRelatedEntitiesDataSource() datasource.DataSource {
+	return &RelatedEntitiesDataSource{}
+}
+
+type RelatedEntitiesDataSource struct {
+	portClient *cli.PortClient
+}
+
+func (d *RelatedEntitiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (d *RelatedEntitiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_related_entities"
+}
+
+func (d *RelatedEntitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RelatedEntitiesDataModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searchResult, err := d.portClient.Search(ctx, relatedEntitiesToPortBody(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to search related entities", err.Error())
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s", data.EntityBlueprint.ValueString(), data.EntityIdentifier.ValueString()))
+	data.MatchingBlueprints = goStringListToTFList(searchResult.MatchingBlueprints)
+
+	entities, err := searchResultToEntities(ctx, d.portClient, searchResult)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to read blueprint", err.Error())
+		return
+	}
+	data.Entities = entities
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
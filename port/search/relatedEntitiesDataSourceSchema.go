@@ -0,0 +1,102 @@
+package search
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func RelatedEntitiesSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"entity_identifier": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the entity to find the related entities of",
+			Required:            true,
+		},
+		"entity_blueprint": schema.StringAttribute{
+			MarkdownDescription: "The blueprint identifier of the entity to find the related entities of",
+			Required:            true,
+		},
+		"direction": schema.StringAttribute{
+			MarkdownDescription: "The direction of the relation, `upstream` for the entities the entity relates to and `downstream` for the entities that relate to the entity. Defaults to `upstream`",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf("upstream", "downstream"),
+			},
+		},
+		"blueprint": schema.StringAttribute{
+			MarkdownDescription: "Return only the related entities of this blueprint",
+			Optional:            true,
+		},
+		"depth": schema.Int64Attribute{
+			MarkdownDescription: "The maximum number of relations to follow from the entity",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		},
+		"matching_blueprints": schema.ListAttribute{
+			MarkdownDescription: "The blueprints of the related entities",
+			Computed:            true,
+			ElementType:         types.StringType,
+		},
+		"entities": schema.ListNestedAttribute{
+			MarkdownDescription: "A list of the related entities",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: EntitySchema(),
+			},
+		},
+	}
+}
+
+func (d *RelatedEntitiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: RelatedEntitiesDataSourceMarkdownDescription,
+		Attributes:          RelatedEntitiesSchema(),
+	}
+}
+
+var RelatedEntitiesDataSourceMarkdownDescription = `
+
+# Related Entities Data Source
+
+The related entities data source allows you to find the entities related to an entity in Port, without writing the search query yourself.
+
+See the [Port documentation](https://docs.getport.io/search-and-query/#relatedto) for more information about the ` + "`relatedTo`" + ` operator.
+
+## Example Usage
+
+### All the deployments of a service:
+
+` + "```hcl" + `
+
+data "port_related_entities" "ads_deployments" {
+  entity_identifier = "ads"
+  entity_blueprint  = "service"
+  direction         = "downstream"
+  blueprint         = "deployment"
+}
+
+` + "\n```" + `
+
+### The service that owns an entity:
+
+` + "```hcl" + `
+
+data "port_related_entities" "ads_deployment_service" {
+  entity_identifier = "ads-production"
+  entity_blueprint  = "deployment"
+  direction         = "upstream"
+  blueprint         = "service"
+  depth             = 1
+}
+
+` + "\n```" + ``
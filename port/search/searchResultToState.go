@@ -198,7 +198,7 @@ func refreshScorecardsEntityState(state *EntityModel, e *cli.Entity) {
 	}
 }
 
-func refreshEntityState(ctx context.Context, e *cli.Entity, b *cli.Blueprint) *EntityModel {
+func refreshEntityState(ctx context.Context, e *cli.Entity, b *cli.Blueprint) (*EntityModel, error) {
 	state := &EntityModel{}
 	state.Identifier = types.StringValue(e.Identifier)
 	state.Blueprint = types.StringValue(e.Blueprint)
@@ -227,5 +227,15 @@ func refreshEntityState(ctx context.Context, e *cli.Entity, b *cli.Blueprint) *E
 		refreshScorecardsEntityState(state, e)
 	}
 
-	return state
+	properties := e.Properties
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	propertiesJSON, err := json.Marshal(properties)
+	if err != nil {
+		return nil, err
+	}
+	state.PropertiesJSON = types.StringValue(string(propertiesJSON))
+
+	return state, nil
 }
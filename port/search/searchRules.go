@@ -0,0 +1,318 @@
+package search
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/flex"
+)
+
+var searchOperators = []string{
+	"=", "!=", ">", ">=", "<", "<=",
+	"contains", "doesNotContains", "containsAny",
+	"beginsWith", "doesNotBeginsWith", "endsWith", "doesNotEndsWith",
+	"in", "notIn", "between", "notBetween",
+	"isEmpty", "isNotEmpty", "relatedTo",
+}
+
+var stringOperators = map[string]bool{
+	"contains": true, "doesNotContains": true,
+	"beginsWith": true, "doesNotBeginsWith": true, "endsWith": true, "doesNotEndsWith": true,
+}
+
+var comparisonOperators = map[string]bool{">": true, ">=": true, "<": true, "<=": true}
+
+var rangeOperators = map[string]bool{"between": true, "notBetween": true}
+
+var listOperators = map[string]bool{"in": true, "notIn": true, "containsAny": true}
+
+var emptinessOperators = map[string]bool{"isEmpty": true, "isNotEmpty": true}
+
+// metaProperties are the properties every entity has regardless of its blueprint, mapped to their property type and
+// format
+var metaProperties = map[string]cli.BlueprintProperty{
+	"$identifier": {Type: "string"},
+	"$title":      {Type: "string"},
+	"$blueprint":  {Type: "string"},
+	"$team":       {Type: "array"},
+	"$icon":       {Type: "string"},
+	"$createdAt":  {Type: "string", Format: stringPointer("date-time")},
+	"$updatedAt":  {Type: "string", Format: stringPointer("date-time")},
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
+
+// searchRulesFromList reads the rules of the configuration, recursing into groups of rules. The rules are decoded
+// without their combinator and rules attributes, which the rules at the deepest level of nesting don't have, and those
+// are read separately for groups. The rules are nil when the list is null, and rules that are unknown as a whole have
+// an unknown operator.
+func searchRulesFromList(ctx context.Context, list types.List) ([]SearchRuleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if list.IsNull() {
+		return nil, diags
+	}
+
+	rules := make([]SearchRuleModel, 0, len(list.Elements()))
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() || object.IsNull() {
+			rules = append(rules, SearchRuleModel{Operator: types.StringUnknown()})
+			continue
+		}
+
+		attributes := map[string]attr.Value{}
+		attributeTypes := map[string]attr.Type{}
+		for name, value := range object.Attributes() {
+			if name == "combinator" || name == "rules" {
+				continue
+			}
+			attributes[name] = value
+			attributeTypes[name] = value.Type(ctx)
+		}
+		ruleObject, d := types.ObjectValue(attributeTypes, attributes)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var rule SearchRuleModel
+		diags.Append(ruleObject.As(ctx, &rule, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if combinator, ok := object.Attributes()["combinator"].(types.String); ok {
+			rule.Combinator = combinator
+		}
+		if groupRules, ok := object.Attributes()["rules"].(types.List); ok {
+			rule.Rules, d = searchRulesFromList(ctx, groupRules)
+			diags.Append(d...)
+			if diags.HasError() {
+				return nil, diags
+			}
+		}
+		rules = append(rules, rule)
+	}
+	return rules, diags
+}
+
+func searchRulesToQuery(combinator types.String, rules []SearchRuleModel) map[string]any {
+	rulesBody := make([]any, len(rules))
+	for i, rule := range rules {
+		if rule.Rules == nil {
+			rulesBody[i] = searchRuleToBody(rule)
+			continue
+		}
+		rulesBody[i] = searchRulesToQuery(rule.Combinator, rule.Rules)
+	}
+
+	return map[string]any{
+		"combinator": combinatorOrDefault(combinator),
+		"rules":      rulesBody,
+	}
+}
+
+func combinatorOrDefault(combinator types.String) string {
+	if combinator.IsNull() {
+		return "and"
+	}
+	return combinator.ValueString()
+}
+
+func searchRuleToBody(rule SearchRuleModel) map[string]any {
+	body := map[string]any{
+		"operator": rule.Operator.ValueString(),
+	}
+
+	if !rule.Property.IsNull() {
+		body["property"] = rule.Property.ValueString()
+	}
+
+	if !rule.Relation.IsNull() {
+		body["relation"] = rule.Relation.ValueString()
+	}
+
+	if !rule.Blueprint.IsNull() {
+		body["blueprint"] = rule.Blueprint.ValueString()
+	}
+
+	if !rule.Direction.IsNull() {
+		body["direction"] = rule.Direction.ValueString()
+	}
+
+	if !rule.Required.IsNull() {
+		body["required"] = rule.Required.ValueBool()
+	}
+
+	switch {
+	case !rule.Value.IsNull():
+		body["value"] = rule.Value.ValueString()
+	case !rule.NumberValue.IsNull():
+		body["value"] = rule.NumberValue.ValueFloat64()
+	case !rule.BoolValue.IsNull():
+		body["value"] = rule.BoolValue.ValueBool()
+	case rule.Values != nil:
+		body["value"] = flex.TerraformStringListToGoArray(rule.Values)
+	case !rule.Preset.IsNull():
+		body["value"] = map[string]any{"preset": rule.Preset.ValueString()}
+	case !rule.From.IsNull() || !rule.To.IsNull():
+		body["value"] = map[string]any{"from": rule.From.ValueString(), "to": rule.To.ValueString()}
+	}
+
+	return body
+}
+
+func validateSearchRules(rules []SearchRuleModel, rulesPath path.Path, diags *diag.Diagnostics) {
+	for i, rule := range rules {
+		p := rulesPath.AtListIndex(i)
+		if rule.Rules != nil {
+			if !rule.Operator.IsNull() || !rule.Property.IsNull() || !rule.Relation.IsNull() {
+				diags.AddAttributeError(p, "invalid search rule", "a nested group of rules can't have an operator, property or relation")
+			}
+			validateSearchRules(rule.Rules, p.AtName("rules"), diags)
+			continue
+		}
+		validateSearchRule(rule, p, diags)
+	}
+}
+
+func validateSearchRule(rule SearchRuleModel, p path.Path, diags *diag.Diagnostics) {
+	if rule.Operator.IsUnknown() {
+		return
+	}
+
+	if rule.Operator.IsNull() {
+		diags.AddAttributeError(p.AtName("operator"), "missing operator", "a search rule requires an operator, or a combinator and rules for a nested group of rules")
+		return
+	}
+
+	operator := rule.Operator.ValueString()
+	valuesCount := 0
+	for _, isSet := range []bool{!rule.Value.IsNull(), !rule.NumberValue.IsNull(), !rule.BoolValue.IsNull(), rule.Values != nil} {
+		if isSet {
+			valuesCount++
+		}
+	}
+	isRangeSet := !rule.Preset.IsNull() || !rule.From.IsNull() || !rule.To.IsNull()
+
+	if operator == "relatedTo" {
+		if rule.Blueprint.IsNull() || rule.Value.IsNull() {
+			diags.AddAttributeError(p, "invalid relatedTo rule", "the relatedTo operator requires a blueprint and a value")
+		}
+		if !rule.Property.IsNull() || !rule.Relation.IsNull() {
+			diags.AddAttributeError(p, "invalid relatedTo rule", "the relatedTo operator doesn't accept a property or a relation")
+		}
+		return
+	}
+
+	if !rule.Blueprint.IsNull() || !rule.Direction.IsNull() || !rule.Required.IsNull() {
+		diags.AddAttributeError(p, "invalid search rule", "blueprint, direction and required are only supported by the relatedTo operator")
+	}
+
+	if rule.Property.IsNull() && rule.Relation.IsNull() {
+		diags.AddAttributeError(p, "invalid search rule", fmt.Sprintf("the %s operator requires a property or a relation", operator))
+	}
+
+	switch {
+	case emptinessOperators[operator]:
+		if valuesCount != 0 || isRangeSet {
+			diags.AddAttributeError(p, "invalid search rule", fmt.Sprintf("the %s operator doesn't accept a value", operator))
+		}
+	case listOperators[operator]:
+		if rule.Values == nil || valuesCount != 1 || isRangeSet {
+			diags.AddAttributeError(p, "invalid search rule", fmt.Sprintf("the %s operator requires values", operator))
+		}
+	case rangeOperators[operator]:
+		if valuesCount != 0 || (rule.Preset.IsNull() && (rule.From.IsNull() || rule.To.IsNull())) || (!rule.Preset.IsNull() && (!rule.From.IsNull() || !rule.To.IsNull())) {
+			diags.AddAttributeError(p, "invalid search rule", fmt.Sprintf("the %s operator requires either a preset or from and to", operator))
+		}
+	default:
+		if valuesCount != 1 || rule.Values != nil || isRangeSet {
+			diags.AddAttributeError(p, "invalid search rule", fmt.Sprintf("the %s operator requires exactly one of value, number_value or bool_value", operator))
+		}
+	}
+}
+
+// blueprintOfSearchRules returns the blueprint the rules are scoped to by a top level `$blueprint =` rule, if any
+func blueprintOfSearchRules(combinator types.String, rules []SearchRuleModel) string {
+	if combinatorOrDefault(combinator) == "or" {
+		return ""
+	}
+
+	for _, rule := range rules {
+		if rule.Rules == nil && rule.Property.ValueString() == "$blueprint" && rule.Operator.ValueString() == "=" && !rule.Value.IsNull() {
+			return rule.Value.ValueString()
+		}
+	}
+	return ""
+}
+
+func validateSearchRulesAgainstBlueprint(rules []SearchRuleModel, b *cli.Blueprint, rulesPath path.Path, diags *diag.Diagnostics) {
+	for i, rule := range rules {
+		p := rulesPath.AtListIndex(i)
+		if rule.Rules != nil {
+			validateSearchRulesAgainstBlueprint(rule.Rules, b, p.AtName("rules"), diags)
+			continue
+		}
+		validateSearchRuleAgainstBlueprint(rule, b, p, diags)
+	}
+}
+
+func validateSearchRuleAgainstBlueprint(rule SearchRuleModel, b *cli.Blueprint, p path.Path, diags *diag.Diagnostics) {
+	if !rule.Relation.IsNull() {
+		if _, ok := b.Relations[rule.Relation.ValueString()]; !ok {
+			diags.AddAttributeError(p.AtName("relation"), "unknown relation", fmt.Sprintf("relation %s is not defined in blueprint %s", rule.Relation.ValueString(), b.Identifier))
+		}
+		return
+	}
+
+	if rule.Property.IsNull() || rule.Property.IsUnknown() {
+		return
+	}
+
+	identifier := rule.Property.ValueString()
+	property, ok := metaProperties[identifier]
+	if !ok {
+		property, ok = b.Schema.Properties[identifier]
+	}
+	if !ok {
+		// calculation, mirror and aggregation properties can be searched as well, but their type is not validated
+		_, isCalculation := b.CalculationProperties[identifier]
+		_, isMirror := b.MirrorProperties[identifier]
+		_, isAggregation := b.AggregationProperties[identifier]
+		if !isCalculation && !isMirror && !isAggregation {
+			diags.AddAttributeError(p.AtName("property"), "unknown property", fmt.Sprintf("property %s is not defined in blueprint %s", identifier, b.Identifier))
+		}
+		return
+	}
+
+	operator := rule.Operator.ValueString()
+	isDate := property.Type == "string" && property.Format != nil && (*property.Format == "date-time" || *property.Format == "timer")
+
+	switch {
+	case stringOperators[operator] && property.Type != "string":
+		diags.AddAttributeError(p.AtName("operator"), "invalid operator", fmt.Sprintf("the %s operator is only supported for string properties, %s is of type %s", operator, identifier, property.Type))
+	case comparisonOperators[operator] && property.Type != "number" && !isDate:
+		diags.AddAttributeError(p.AtName("operator"), "invalid operator", fmt.Sprintf("the %s operator is only supported for number and date properties, %s is of type %s", operator, identifier, property.Type))
+	case rangeOperators[operator] && !isDate:
+		diags.AddAttributeError(p.AtName("operator"), "invalid operator", fmt.Sprintf("the %s operator is only supported for date properties", operator))
+	case operator == "containsAny" && property.Type != "array":
+		diags.AddAttributeError(p.AtName("operator"), "invalid operator", fmt.Sprintf("the containsAny operator is only supported for array properties, %s is of type %s", identifier, property.Type))
+	}
+
+	if !rule.NumberValue.IsNull() && property.Type != "number" {
+		diags.AddAttributeError(p.AtName("number_value"), "invalid value", fmt.Sprintf("property %s is of type %s, not number", identifier, property.Type))
+	}
+
+	if !rule.BoolValue.IsNull() && property.Type != "boolean" {
+		diags.AddAttributeError(p.AtName("bool_value"), "invalid value", fmt.Sprintf("property %s is of type %s, not boolean", identifier, property.Type))
+	}
+}
@@ -6,17 +6,86 @@ import (
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
 )
 
-func searchResourceToPortBody(state *SearchDataModel) (*cli.SearchRequestQuery, error) {
+// entitiesImportToPortBody searches the entities of the blueprint, narrowed down by the rules when they are set
+func entitiesImportToPortBody(state *EntitiesImportDataModel, searchRules []SearchRuleModel) *cli.SearchRequestQuery {
+	rules := []any{
+		map[string]any{
+			"property": "$blueprint",
+			"operator": "=",
+			"value":    state.Blueprint.ValueString(),
+		},
+	}
+	if searchRules != nil {
+		rules = append(rules, searchRulesToQuery(state.Combinator, searchRules))
+	}
+
+	query := map[string]any{
+		"combinator": "and",
+		"rules":      rules,
+	}
+	excludeCalculatedProperties := true
+	return &cli.SearchRequestQuery{
+		Query:                       &query,
+		ExcludeCalculatedProperties: &excludeCalculatedProperties,
+	}
+}
+
+func searchResourceToPortBody(state *SearchDataModel, rules []SearchRuleModel) (*cli.SearchRequestQuery, error) {
 	query, err := utils.TerraformJsonStringToGoObject(state.Query.ValueStringPointer())
 	if err != nil {
 		return nil, err
 	}
 
-	return &cli.SearchRequestQuery{
+	if rules != nil {
+		rulesQuery := searchRulesToQuery(state.Combinator, rules)
+		query = &rulesQuery
+	}
+
+	searchRequest := &cli.SearchRequestQuery{
 		Query:                       query,
 		ExcludeCalculatedProperties: state.ExcludeCalculatedProperties.ValueBoolPointer(),
 		Include:                     flex.TerraformStringListToGoArray(state.Include),
 		Exclude:                     flex.TerraformStringListToGoArray(state.Exclude),
 		AttachTitleToRelation:       state.AttachTitleToRelation.ValueBoolPointer(),
-	}, nil
+	}
+
+	// the entities are sorted after the search, so the limit can only be applied by the API when there's no sort
+	if !state.Limit.IsNull() && state.Sort == nil {
+		limit := int(state.Limit.ValueInt64())
+		searchRequest.Limit = &limit
+	}
+
+	return searchRequest, nil
+}
+
+func relatedEntitiesToPortBody(state *RelatedEntitiesDataModel) *cli.SearchRequestQuery {
+	relatedToRule := map[string]any{
+		"operator":  "relatedTo",
+		"blueprint": state.EntityBlueprint.ValueString(),
+		"value":     state.EntityIdentifier.ValueString(),
+	}
+	if !state.Direction.IsNull() {
+		relatedToRule["direction"] = state.Direction.ValueString()
+	}
+	if !state.Depth.IsNull() {
+		relatedToRule["maxHops"] = state.Depth.ValueInt64()
+	}
+
+	rules := []any{relatedToRule}
+	if !state.Blueprint.IsNull() {
+		rules = append(rules, map[string]any{
+			"operator": "=",
+			"property": "$blueprint",
+			"value":    state.Blueprint.ValueString(),
+		})
+	}
+
+	query := map[string]any{
+		"combinator": "and",
+		"rules":      rules,
+	}
+
+	return &cli.SearchRequestQuery{
+		Query: &query,
+	}
 }
@@ -0,0 +1,70 @@
+package search
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// sortValue returns the value of the entity the search results are sorted by, nil when the entity doesn't have it
+func sortValue(e cli.Entity, property string) any {
+	switch property {
+	case "$identifier":
+		return e.Identifier
+	case "$title":
+		return e.Title
+	case "$blueprint":
+		return e.Blueprint
+	case "$createdAt":
+		if e.CreatedAt == nil {
+			return nil
+		}
+		return *e.CreatedAt
+	case "$updatedAt":
+		if e.UpdatedAt == nil {
+			return nil
+		}
+		return *e.UpdatedAt
+	}
+	return e.Properties[property]
+}
+
+// lessSortValues compares two values of the same property, numbers and dates are compared by value and anything else
+// by its string representation
+func lessSortValues(a any, b any) bool {
+	switch a := a.(type) {
+	case float64:
+		if b, ok := b.(float64); ok {
+			return a < b
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Before(b)
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			return !a && b
+		}
+	}
+	return fmt.Sprintf("%v", a) < fmt.Sprintf("%v", b)
+}
+
+// sortEntities sorts the entities in place, entities without a value for the property are always placed last
+func sortEntities(entities []cli.Entity, sortModel *SearchSortModel) {
+	property := sortModel.Property.ValueString()
+	descending := sortModel.Order.ValueString() == "desc"
+
+	sort.SliceStable(entities, func(i, j int) bool {
+		a := sortValue(entities[i], property)
+		b := sortValue(entities[j], property)
+		if a == nil || b == nil {
+			return a != nil
+		}
+		if descending {
+			return lessSortValues(b, a)
+		}
+		return lessSortValues(a, b)
+	})
+}
@@ -0,0 +1,14 @@
+package secret
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SecretModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Value       types.String `tfsdk:"value"`
+	Description types.String `tfsdk:"description"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
@@ -0,0 +1,28 @@
+package secret
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// refreshSecretState writes the secret to the state, the value is never returned by Port and is kept as configured
+func refreshSecretState(state *SecretModel, s *cli.Secret) {
+	state.Name = types.StringValue(s.SecretName)
+	state.Description = types.StringNull()
+	if s.Description != nil && *s.Description != "" {
+		state.Description = types.StringValue(*s.Description)
+	}
+	writeSecretComputedFieldsToState(state, s)
+}
+
+func writeSecretComputedFieldsToState(state *SecretModel, s *cli.Secret) {
+	state.ID = types.StringValue(s.SecretName)
+	state.CreatedAt = types.StringNull()
+	if s.CreatedAt != nil {
+		state.CreatedAt = types.StringValue(s.CreatedAt.String())
+	}
+	state.UpdatedAt = types.StringNull()
+	if s.UpdatedAt != nil {
+		state.UpdatedAt = types.StringValue(s.UpdatedAt.String())
+	}
+}
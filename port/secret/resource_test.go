@@ -0,0 +1,77 @@
+package secret_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+func TestAccPortSecret(t *testing.T) {
+	secretName := utils.GenID()
+	var testAccSecretConfigCreate = fmt.Sprintf(`
+	resource "port_secret" "secret" {
+		name = "%s"
+		value = "first-value"
+		description = "Test description"
+	}`, secretName)
+
+	var testAccSecretConfigUpdate = fmt.Sprintf(`
+	resource "port_secret" "secret" {
+		name = "%s"
+		value = "second-value"
+	}`, secretName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccSecretConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_secret.secret", "id", secretName),
+					resource.TestCheckResourceAttr("port_secret.secret", "name", secretName),
+					resource.TestCheckResourceAttr("port_secret.secret", "value", "first-value"),
+					resource.TestCheckResourceAttr("port_secret.secret", "description", "Test description"),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccSecretConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_secret.secret", "name", secretName),
+					resource.TestCheckResourceAttr("port_secret.secret", "value", "second-value"),
+					resource.TestCheckNoResourceAttr("port_secret.secret", "description"),
+				),
+			},
+			{
+				ResourceName:            "port_secret.secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           secretName,
+				ImportStateVerifyIgnore: []string{"value"},
+			},
+		},
+	})
+}
+
+func TestAccPortSecretInvalidName(t *testing.T) {
+	var testAccSecretConfigCreate = `
+	resource "port_secret" "secret" {
+		name = "invalid name"
+		value = "value"
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccSecretConfigCreate,
+				ExpectError: regexp.MustCompile(`must contain only letters, digits, _ and -`),
+			},
+		},
+	})
+}
@@ -0,0 +1,98 @@
+package secret
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+func SecretSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the secret, referenced by actions as `{{ .secrets.<name> }}`",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(regexp.MustCompile(consts.SecretNamePattern), "must contain only letters, digits, _ and -"),
+			},
+		},
+		"value": schema.StringAttribute{
+			MarkdownDescription: "The value of the secret. Port never returns the value, so changes made outside of Terraform are not detected",
+			Required:            true,
+			Sensitive:           true,
+		},
+		"description": schema.StringAttribute{
+			MarkdownDescription: "The description of the secret",
+			Optional:            true,
+		},
+		"created_at": schema.StringAttribute{
+			MarkdownDescription: "The creation date of the secret",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"updated_at": schema.StringAttribute{
+			MarkdownDescription: "The last update date of the secret",
+			Computed:            true,
+		},
+	}
+}
+
+func (r *SecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceMarkdownDescription,
+		Attributes:          SecretSchema(),
+	}
+}
+
+var ResourceMarkdownDescription = `
+
+# Secret
+
+Manages a secret of the Port organization. Secrets are referenced by the invocation methods of actions as ` + "`{{ .secrets.<name> }}`" + `, keeping credentials out of the action definitions.
+
+Port never returns the value of a secret, so the value is only written and is never read back. Terraform still keeps the configured value in the state, marked as sensitive.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_secret" "deploy_token" {
+  name        = "DEPLOY_TOKEN"
+  value       = var.deploy_token
+  description = "The token of the deployment service"
+}
+
+resource "port_action" "deploy" {
+  title      = "Deploy"
+  identifier = "deploy"
+  self_service_trigger = {
+    operation = "DAY-2"
+    blueprint_identifier = "microservice"
+  }
+  webhook_method = {
+    url = "https://deploy.example.com"
+    headers = {
+      "Authorization" = "Bearer {{ .secrets.${port_secret.deploy_token.name} }}"
+    }
+  }
+}
+
+` + "```" + `
+`
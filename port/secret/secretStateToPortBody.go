@@ -0,0 +1,16 @@
+package secret
+
+import (
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+)
+
+// secretStateToPortBody always sends the description, as secrets are updated with PATCH and a description that is
+// left out would be kept. A removed description is sent as an empty string.
+func secretStateToPortBody(state *SecretModel) *cli.Secret {
+	description := state.Description.ValueString()
+	return &cli.Secret{
+		SecretName:  state.Name.ValueString(),
+		SecretValue: state.Value.ValueStringPointer(),
+		Description: &description,
+	}
+}
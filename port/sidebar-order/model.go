@@ -0,0 +1,11 @@
+package sidebar_order
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SidebarOrderModel struct {
+	ID     types.String   `tfsdk:"id"`
+	Folder types.String   `tfsdk:"folder"`
+	Items  []types.String `tfsdk:"items"`
+}
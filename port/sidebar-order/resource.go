@@ -0,0 +1,172 @@
+package sidebar_order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+var _ resource.Resource = &SidebarOrderResource{}
+var _ resource.ResourceWithImportState = &SidebarOrderResource{}
+
+func NewSidebarOrderResource() resource.Resource {
+	return &SidebarOrderResource{}
+}
+
+type SidebarOrderResource struct {
+	portClient *cli.PortClient
+}
+
+func (r *SidebarOrderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sidebar_order"
+}
+
+func (r *SidebarOrderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.portClient = req.ProviderData.(*cli.PortClient)
+}
+
+func (r *SidebarOrderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state *SidebarOrderModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sidebar, _, err := r.portClient.GetSidebar(ctx, consts.CatalogSidebar)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get sidebar", err.Error())
+		return
+	}
+
+	folder := state.Folder.ValueStringPointer()
+	if folder != nil && !hasFolder(sidebar, *folder) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	children := orderedChildren(sidebar, folder)
+	state.ID = types.StringValue(sidebarOrderID(state.Folder))
+	state.Items = make([]types.String, 0, len(children))
+	for _, item := range children {
+		state.Items = append(state.Items, types.StringValue(item.Identifier))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SidebarOrderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var state *SidebarOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SidebarOrderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *SidebarOrderModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyOrder(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Delete only removes the ordering from the state, the pages and folders keep their positions
+func (r *SidebarOrderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SidebarOrderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("id"), req.ID,
+	)...)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(
+		ctx, path.Root("folder"), sidebarOrderFolder(req.ID),
+	)...)
+}
+
+// applyOrder places the items one after the other, starting from the top of the folder. As every item is placed
+// after the one that was placed before it, the order doesn't depend on the positions the items had before.
+// Port has no endpoint that orders a whole folder, so every item is moved by its own request and a failure leaves
+// the items before it already moved.
+func (r *SidebarOrderResource) applyOrder(ctx context.Context, state *SidebarOrderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	sidebar, _, err := r.portClient.GetSidebar(ctx, consts.CatalogSidebar)
+	if err != nil {
+		diags.AddError("failed to get sidebar", err.Error())
+		return diags
+	}
+
+	folder := state.Folder.ValueStringPointer()
+	if folder != nil && !hasFolder(sidebar, *folder) {
+		diags.AddAttributeError(path.Root("folder"), "folder not found", fmt.Sprintf("folder %s was not found in the sidebar", *folder))
+		return diags
+	}
+
+	items, err := sidebarItemsToOrder(sidebar, folder, state.Items)
+	if err != nil {
+		diags.AddAttributeError(path.Root("items"), "invalid sidebar order", err.Error())
+		return diags
+	}
+
+	if !isOrdered(orderedChildren(sidebar, folder), items) {
+		var after *string
+		for _, item := range items {
+			err = r.portClient.MoveSidebarItem(ctx, item, cli.SidebarItemPosition{Parent: folder, After: after})
+			if err != nil {
+				diags.AddError("failed to order sidebar", r.partialOrderError(ctx, folder, items, err).Error())
+				return diags
+			}
+			identifier := item.Identifier
+			after = &identifier
+		}
+	}
+
+	state.ID = types.StringValue(sidebarOrderID(state.Folder))
+	return diags
+}
+
+// partialOrderError re-reads the sidebar after moving an item failed, so the error tells which items were already
+// moved to their position before the failure
+func (r *SidebarOrderResource) partialOrderError(ctx context.Context, folder *string, items []cli.SidebarItem, moveErr error) error {
+	sidebar, _, err := r.portClient.GetSidebar(ctx, consts.CatalogSidebar)
+	if err != nil {
+		return fmt.Errorf("%w, and reading the sidebar to find the items that were already moved failed: %s", moveErr, err.Error())
+	}
+
+	moved := orderedPrefix(orderedChildren(sidebar, folder), items)
+	if len(moved) == 0 {
+		return fmt.Errorf("%w, no items were moved", moveErr)
+	}
+	return fmt.Errorf("%w, the sidebar is partially ordered, these items were already moved: %s", moveErr, strings.Join(moved, ", "))
+}
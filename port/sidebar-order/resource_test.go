@@ -0,0 +1,182 @@
+package sidebar_order_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/acctest"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/utils"
+)
+
+// testAccCheckFolderOrder reads the sidebar from Port and checks that the pages of the folder follow each other in
+// the given order
+func testAccCheckFolderOrder(folderIdentifier string, identifiers []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		baseURL := os.Getenv("PORT_BASE_URL")
+		if baseURL == "" {
+			baseURL = consts.DefaultBaseUrl
+		}
+		c, err := cli.New(baseURL)
+		if err != nil {
+			return err
+		}
+		ctx := context.Background()
+		if _, err = c.Authenticate(ctx, os.Getenv("PORT_CLIENT_ID"), os.Getenv("PORT_CLIENT_SECRET")); err != nil {
+			return err
+		}
+		sidebar, _, err := c.GetSidebar(ctx, consts.CatalogSidebar)
+		if err != nil {
+			return err
+		}
+
+		items := map[string]cli.SidebarItem{}
+		for _, item := range sidebar.Items {
+			items[item.Identifier] = item
+		}
+		for i, identifier := range identifiers {
+			item, ok := items[identifier]
+			if !ok {
+				return fmt.Errorf("%s was not found in the sidebar", identifier)
+			}
+			if item.Parent == nil || *item.Parent != folderIdentifier {
+				return fmt.Errorf("%s is not in folder %s", identifier, folderIdentifier)
+			}
+			if i == 0 {
+				if item.After != nil && *item.After != "" {
+					return fmt.Errorf("%s should be first in folder %s, but it is after %s", identifier, folderIdentifier, *item.After)
+				}
+				continue
+			}
+			if item.After == nil || *item.After != identifiers[i-1] {
+				return fmt.Errorf("%s should be after %s", identifier, identifiers[i-1])
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCreateFolderWithPagesConfig(folderIdentifier string, pageIdentifiers []string) string {
+	config := fmt.Sprintf(`
+	resource "port_folder" "folder" {
+		identifier = "%s"
+		title = "Folder"
+	}
+	`, folderIdentifier)
+	for i, pageIdentifier := range pageIdentifiers {
+		config += fmt.Sprintf(`
+	resource "port_page" "page_%d" {
+		identifier = "%s"
+		title = "Page %d"
+		type = "dashboard"
+		parent = port_folder.folder.identifier
+	}
+	`, i, pageIdentifier, i)
+	}
+	return config
+}
+
+func TestAccPortSidebarOrder(t *testing.T) {
+	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	folderIdentifier := utils.GenID()
+	pageIdentifiers := []string{utils.GenID(), utils.GenID(), utils.GenID()}
+	folderConfig := testAccCreateFolderWithPagesConfig(folderIdentifier, pageIdentifiers)
+
+	var testAccSidebarOrderConfigCreate = folderConfig + `
+	resource "port_sidebar_order" "order" {
+		folder = port_folder.folder.identifier
+		items = [
+			port_page.page_2.identifier,
+			port_page.page_0.identifier,
+			port_page.page_1.identifier,
+		]
+	}`
+
+	var testAccSidebarOrderConfigUpdate = folderConfig + `
+	resource "port_sidebar_order" "order" {
+		folder = port_folder.folder.identifier
+		items = [
+			port_page.page_1.identifier,
+			port_page.page_2.identifier,
+			port_page.page_0.identifier,
+		]
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ProviderConfig + testAccSidebarOrderConfigCreate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_sidebar_order.order", "id", folderIdentifier),
+					resource.TestCheckResourceAttr("port_sidebar_order.order", "items.#", "3"),
+					resource.TestCheckResourceAttr("port_sidebar_order.order", "items.0", pageIdentifiers[2]),
+					resource.TestCheckResourceAttr("port_sidebar_order.order", "items.1", pageIdentifiers[0]),
+					resource.TestCheckResourceAttr("port_sidebar_order.order", "items.2", pageIdentifiers[1]),
+				),
+			},
+			{
+				Config: acctest.ProviderConfig + testAccSidebarOrderConfigUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("port_sidebar_order.order", "items.0", pageIdentifiers[1]),
+					resource.TestCheckResourceAttr("port_sidebar_order.order", "items.1", pageIdentifiers[2]),
+					resource.TestCheckResourceAttr("port_sidebar_order.order", "items.2", pageIdentifiers[0]),
+				),
+			},
+			{
+				ResourceName:      "port_sidebar_order.order",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     folderIdentifier,
+			},
+			{
+				// Deleting the sidebar order keeps the pages in the order it set
+				Config: acctest.ProviderConfig + folderConfig,
+				Check: testAccCheckFolderOrder(folderIdentifier, []string{
+					pageIdentifiers[1],
+					pageIdentifiers[2],
+					pageIdentifiers[0],
+				}),
+			},
+		},
+	})
+}
+
+func TestAccPortSidebarOrderMissingItem(t *testing.T) {
+	err := os.Setenv("PORT_BETA_FEATURES_ENABLED", "true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	folderIdentifier := utils.GenID()
+	pageIdentifiers := []string{utils.GenID(), utils.GenID()}
+
+	var testAccSidebarOrderConfigCreate = testAccCreateFolderWithPagesConfig(folderIdentifier, pageIdentifiers) + `
+	resource "port_sidebar_order" "order" {
+		folder = port_folder.folder.identifier
+		items = [
+			port_page.page_1.identifier,
+		]
+		depends_on = [port_page.page_0]
+	}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      acctest.ProviderConfig + testAccSidebarOrderConfigCreate,
+				ExpectError: regexp.MustCompile(`items must list all of its pages and folders`),
+			},
+		},
+	})
+}
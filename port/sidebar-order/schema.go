@@ -0,0 +1,105 @@
+package sidebar_order
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func SidebarOrderSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"folder": schema.StringAttribute{
+			MarkdownDescription: "The identifier of the folder that is ordered, default is the root of the sidebar",
+			Optional:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"items": schema.ListAttribute{
+			MarkdownDescription: "The identifiers of all the pages and folders in the folder, in the order they are shown in the sidebar",
+			Required:            true,
+			ElementType:         types.StringType,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+			},
+		},
+	}
+}
+
+func (r *SidebarOrderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: ResourceMarkdownDescription,
+		Attributes:          SidebarOrderSchema(),
+	}
+}
+
+var ResourceMarkdownDescription = `
+
+# Sidebar Order
+
+Sets the complete order of the pages and folders in a folder of the catalog sidebar, or in the root of the sidebar when ` + "`folder`" + ` isn't set.
+
+Ordering pages with ` + "`after`" + ` makes every page depend on the page before it, so reordering a section often fails on the dependencies between the ` + "`after`" + ` references. A sidebar order sets the order of the whole folder in one resource instead, placing the items one after the other in the listed order.
+
+` + "`items`" + ` must list all the pages and folders in the folder, and they must already be in the folder, by setting their ` + "`parent`" + `. Pages and folders ordered by a sidebar order shouldn't set ` + "`after`" + `.
+
+Port has no endpoint that orders a whole folder at once, so the items are moved one by one and ordering isn't atomic. When moving an item fails, the error lists the items that were already moved, and the next apply orders the whole folder again.
+
+Deleting a sidebar order only removes it from the Terraform state, the pages and folders keep their order.
+
+## Example Usage
+
+` + "```hcl" + `
+
+resource "port_folder" "services" {
+  identifier = "services"
+  title      = "Services"
+}
+
+resource "port_page" "microservices" {
+  identifier = "microservices"
+  title      = "Microservices"
+  type       = "blueprint-entities"
+  blueprint  = "microservice"
+  parent     = port_folder.services.identifier
+}
+
+resource "port_page" "services_dashboard" {
+  identifier = "services_dashboard"
+  title      = "Services Dashboard"
+  type       = "dashboard"
+  parent     = port_folder.services.identifier
+}
+
+resource "port_sidebar_order" "services" {
+  folder = port_folder.services.identifier
+  items  = [
+    port_page.services_dashboard.identifier,
+    port_page.microservices.identifier,
+  ]
+}
+
+` + "```" + `
+
+## Import
+
+Sidebar orders are imported by the identifier of their folder, or by ` + "`$root`" + ` for the root of the sidebar:
+
+` + "```shell" + `
+terraform import port_sidebar_order.services services
+terraform import port_sidebar_order.root '$root'
+` + "```" + `
+`
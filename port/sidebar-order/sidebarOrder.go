@@ -0,0 +1,148 @@
+package sidebar_order
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
+	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
+)
+
+// rootID is the id of the ordering of the root of the sidebar, which isn't a folder
+const rootID = "$root"
+
+func sidebarOrderID(folder types.String) string {
+	if folder.IsNull() {
+		return rootID
+	}
+	return folder.ValueString()
+}
+
+func sidebarOrderFolder(id string) types.String {
+	if id == rootID {
+		return types.StringNull()
+	}
+	return types.StringValue(id)
+}
+
+func hasFolder(sidebar *cli.Sidebar, folder string) bool {
+	for _, item := range sidebar.Items {
+		if item.SidebarType == consts.SidebarFolder && item.Identifier == folder {
+			return true
+		}
+	}
+	return false
+}
+
+func isOrdered(current []cli.SidebarItem, items []cli.SidebarItem) bool {
+	if len(current) != len(items) {
+		return false
+	}
+	for i := range current {
+		if current[i].Identifier != items[i].Identifier {
+			return false
+		}
+	}
+	return true
+}
+
+// orderedPrefix returns the identifiers of the leading items that are already in their position
+func orderedPrefix(current []cli.SidebarItem, items []cli.SidebarItem) []string {
+	var prefix []string
+	for i := range items {
+		if i >= len(current) || current[i].Identifier != items[i].Identifier {
+			break
+		}
+		prefix = append(prefix, items[i].Identifier)
+	}
+	return prefix
+}
+
+func isChildOf(item cli.SidebarItem, folder *string) bool {
+	if item.Parent == nil || *item.Parent == "" {
+		return folder == nil
+	}
+	return folder != nil && *item.Parent == *folder
+}
+
+// orderedChildren returns the pages and folders in the folder, or in the root of the sidebar when folder is nil, in
+// the order they are shown by following their after references. Items that can't be reached from the first item,
+// which only happens when the references are broken, are returned last in the order of the sidebar.
+func orderedChildren(sidebar *cli.Sidebar, folder *string) []cli.SidebarItem {
+	var children []cli.SidebarItem
+	siblings := map[string]bool{}
+	for _, item := range sidebar.Items {
+		if isChildOf(item, folder) {
+			children = append(children, item)
+			siblings[item.Identifier] = true
+		}
+	}
+
+	// The items are keyed by the item they come after, with items placed first keyed by ""
+	byAfter := map[string][]cli.SidebarItem{}
+	for _, item := range children {
+		after := ""
+		if item.After != nil && siblings[*item.After] {
+			after = *item.After
+		}
+		byAfter[after] = append(byAfter[after], item)
+	}
+
+	ordered := make([]cli.SidebarItem, 0, len(children))
+	visited := map[string]bool{}
+	var visit func(after string)
+	visit = func(after string) {
+		for _, item := range byAfter[after] {
+			if visited[item.Identifier] {
+				continue
+			}
+			visited[item.Identifier] = true
+			ordered = append(ordered, item)
+			visit(item.Identifier)
+		}
+	}
+	visit("")
+
+	for _, item := range children {
+		if !visited[item.Identifier] {
+			ordered = append(ordered, item)
+		}
+	}
+	return ordered
+}
+
+// sidebarItemsToOrder checks that the items are the complete contents of the folder and returns them as sidebar
+// items, in the configured order
+func sidebarItemsToOrder(sidebar *cli.Sidebar, folder *string, items []types.String) ([]cli.SidebarItem, error) {
+	folderName := "the root of the sidebar"
+	if folder != nil {
+		folderName = fmt.Sprintf("folder %s", *folder)
+	}
+
+	byIdentifier := map[string]cli.SidebarItem{}
+	for _, item := range sidebar.Items {
+		byIdentifier[item.Identifier] = item
+	}
+
+	listed := map[string]bool{}
+	toOrder := make([]cli.SidebarItem, 0, len(items))
+	for _, identifier := range items {
+		item, ok := byIdentifier[identifier.ValueString()]
+		if !ok {
+			return nil, fmt.Errorf("%s was not found in the sidebar", identifier.ValueString())
+		}
+		if !isChildOf(item, folder) {
+			return nil, fmt.Errorf("%s %s is not in %s, set its parent to move it there", item.SidebarType, item.Identifier, folderName)
+		}
+		listed[item.Identifier] = true
+		toOrder = append(toOrder, item)
+	}
+
+	for _, item := range orderedChildren(sidebar, folder) {
+		if !listed[item.Identifier] {
+			return nil, fmt.Errorf("%s %s is in %s but is missing from items, items must list all of its pages and folders", item.SidebarType, item.Identifier, folderName)
+		}
+	}
+
+	return toOrder, nil
+}
@@ -2,27 +2,34 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/cli"
 	"github.com/port-labs/terraform-provider-port-labs/v2/internal/consts"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/action"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/action-permissions"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/action-run"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/aggregation-properties"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/blueprint-permissions"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/entity"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/folder"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/integration"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/page"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/page-permissions"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/scorecard"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/search"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/secret"
+	"github.com/port-labs/terraform-provider-port-labs/v2/port/sidebar-order"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/team"
 	"github.com/port-labs/terraform-provider-port-labs/v2/port/webhook"
 	"github.com/port-labs/terraform-provider-port-labs/v2/version"
 	"os"
+	"time"
 )
 
 var (
@@ -60,6 +67,13 @@ func (p *PortLabsProvider) Schema(ctx context.Context, req provider.SchemaReques
 			"base_url": schema.StringAttribute{
 				Optional: true,
 			},
+			"blueprint_cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "The number of seconds a blueprint read by entities and data sources is reused before it is read again, blueprints changed by the provider are always read again. Set it to 0 to disable the cache. Defaults to 300",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -85,7 +99,12 @@ func (p *PortLabsProvider) Configure(ctx context.Context, req provider.Configure
 		baseUrl = consts.DefaultBaseUrl
 	}
 
-	c, err := cli.New(baseUrl, cli.WithHeader("User-Agent", version.ProviderVersion))
+	opts := []cli.Option{cli.WithHeader("User-Agent", version.ProviderVersion)}
+	if !data.BlueprintCacheTTL.IsNull() {
+		opts = append(opts, cli.WithBlueprintCacheTTL(time.Duration(data.BlueprintCacheTTL.ValueInt64())*time.Second))
+	}
+
+	c, err := cli.New(baseUrl, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to create Port-labs client", err.Error())
 		return
@@ -138,16 +157,26 @@ func (p *PortLabsProvider) Resources(ctx context.Context) []func() resource.Reso
 		integration.NewIntegrationResource,
 		action.NewActionResource,
 		action_permissions.NewActionPermissionsResource,
+		action_run.NewActionRunResource,
 		webhook.NewWebhookResource,
 		scorecard.NewScorecardResource,
 		team.NewTeamResource,
 		page.NewPageResource,
 		page_permissions.NewPagePermissionsResource,
+		secret.NewSecretResource,
+		folder.NewFolderResource,
+		sidebar_order.NewSidebarOrderResource,
 	}
 }
 
 func (p *PortLabsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		search.NewSearchDataSource,
+		search.NewRelatedEntitiesDataSource,
+		entity.NewEntityDataSource,
+		search.NewEntitiesImportDataSource,
+		action.NewActionDataSource,
+		action.NewActionsDataSource,
+		action.NewFormPreviewDataSource,
 	}
 }